@@ -20,12 +20,21 @@ import (
 
 // TokenClient is a client to the token endpoint
 func TokenClient(domain string) *TokenService {
+	return TokenClientFromEndpoints(DomainEndpoints(domain))
+}
+
+// TokenClientFromEndpoints is a client to the token endpoint of endpoints,
+// e.g. as loaded by DiscoverEndpoints. The client API is the tenant base URL;
+// token and revocation requests are sent to the endpoints given.
+func TokenClientFromEndpoints(endpoints Endpoints) *TokenService {
+	base := endpoints.base()
+
 	return &TokenService{
 		&http.Client{
-			Doer: &http.RootClient{
+			Doer: endpoints.doer(base, &http.RootClient{
 				Client: &gohttp.Client{},
-			},
-			API: "https://" + domain,
+			}),
+			API: base,
 		},
 	}
 }
@@ -37,13 +46,16 @@ type API struct {
 	ClientID     string
 	ClientSecret string
 	Scopes       []string
+	// Endpoints overrides the endpoints derived from the domain, e.g. with
+	// those loaded by DiscoverEndpoints
+	Endpoints *Endpoints
 }
 
 func clientCredentialsConfig(_ context.Context, domain string, api API) *clientcredentials.Config {
 	return &clientcredentials.Config{
 		ClientID:     api.ClientID,
 		ClientSecret: api.ClientSecret,
-		TokenURL:     fmt.Sprintf("https://%s/oauth/token", domain),
+		TokenURL:     api.endpoints(domain).TokenURL,
 		Scopes:       api.Scopes,
 		EndpointParams: url.Values{
 			"audience": api.Audience,
@@ -150,12 +162,15 @@ func PromptGrant(url string) (string, error) {
 }
 
 func grantConfig(_ context.Context, domain string, api API) *oauth2.Config {
+	endpoints := api.endpoints(domain)
+
 	return &oauth2.Config{
 		ClientID:     api.ClientID,
 		ClientSecret: api.ClientSecret,
 		Endpoint: oauth2.Endpoint{
-			AuthURL:  fmt.Sprintf("https://%s/authorize", domain),
-			TokenURL: fmt.Sprintf("https://%s/oauth/token", domain),
+			AuthURL:       endpoints.AuthURL,
+			TokenURL:      endpoints.TokenURL,
+			DeviceAuthURL: endpoints.DeviceAuthorizationURL,
 		},
 		Scopes: api.Scopes,
 	}
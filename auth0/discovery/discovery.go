@@ -0,0 +1,155 @@
+package discovery
+
+import (
+	"errors"
+	"fmt"
+	gohttp "net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zenoss/go-auth0/auth0/http"
+)
+
+// DefaultCacheTTL is how long a discovery document is cached
+const DefaultCacheTTL = time.Hour
+
+var (
+	// ErrInsecureURL is returned for plain HTTP base URLs unless AllowInsecure is set
+	ErrInsecureURL = errors.New("go-auth0: discovery requires an https base URL")
+	// ErrIssuerMismatch is returned when a discovery document's issuer is not
+	// the base URL it was fetched from
+	ErrIssuerMismatch = errors.New("go-auth0: discovery document issuer does not match")
+)
+
+// Document is an OpenID Connect discovery document, as served from
+// /.well-known/openid-configuration
+type Document struct {
+	Issuer                            string   `json:"issuer,omitempty"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint,omitempty"`
+	TokenEndpoint                     string   `json:"token_endpoint,omitempty"`
+	UserinfoEndpoint                  string   `json:"userinfo_endpoint,omitempty"`
+	JWKSURI                           string   `json:"jwks_uri,omitempty"`
+	DeviceAuthorizationEndpoint       string   `json:"device_authorization_endpoint,omitempty"`
+	RevocationEndpoint                string   `json:"revocation_endpoint,omitempty"`
+	EndSessionEndpoint                string   `json:"end_session_endpoint,omitempty"`
+	RegistrationEndpoint              string   `json:"registration_endpoint,omitempty"`
+	ScopesSupported                   []string `json:"scopes_supported,omitempty"`
+	ResponseTypesSupported            []string `json:"response_types_supported,omitempty"`
+	GrantTypesSupported               []string `json:"grant_types_supported,omitempty"`
+	SubjectTypesSupported             []string `json:"subject_types_supported,omitempty"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported,omitempty"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported,omitempty"`
+	ClaimsSupported                   []string `json:"claims_supported,omitempty"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported,omitempty"`
+}
+
+type cachedDocument struct {
+	doc     *Document
+	expires time.Time
+}
+
+// Client loads and caches discovery documents
+type Client struct {
+	c   *http.Client
+	ttl time.Duration
+
+	// AllowInsecure permits plain HTTP base URLs, e.g. for local test servers
+	AllowInsecure bool
+
+	mu   sync.Mutex
+	docs map[string]cachedDocument
+}
+
+// New creates a discovery Client that fetches documents with client. A nil
+// client uses a plain http client, and a ttl of zero uses DefaultCacheTTL.
+func New(client *http.Client, ttl time.Duration) *Client {
+	if client == nil {
+		client = &http.Client{
+			Doer: &http.RootClient{
+				Client: &gohttp.Client{Timeout: 30 * time.Second},
+			},
+		}
+	}
+
+	if ttl <= 0 {
+		ttl = DefaultCacheTTL
+	}
+
+	return &Client{
+		c:    client,
+		ttl:  ttl,
+		docs: map[string]cachedDocument{},
+	}
+}
+
+// BaseURL turns an Auth0 domain (example.auth0.com) or a base URL
+// (https://login.example.com) into a base URL without a trailing slash
+func (d *Client) BaseURL(domain string) (string, error) {
+	if !strings.Contains(domain, "://") {
+		return "https://" + strings.TrimRight(domain, "/"), nil
+	}
+
+	u, err := url.Parse(domain)
+	if err != nil {
+		return "", fmt.Errorf("go-auth0: invalid base URL %q: %w", domain, err)
+	}
+
+	if u.Scheme != "https" && !(u.Scheme == "http" && d.AllowInsecure) {
+		return "", fmt.Errorf("%w: %q", ErrInsecureURL, domain)
+	}
+
+	return strings.TrimRight(u.String(), "/"), nil
+}
+
+// Get returns the discovery document for domain, fetching it if it is not
+// cached or the cached copy has expired
+func (d *Client) Get(domain string) (*Document, error) {
+	base, err := d.BaseURL(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	d.mu.Lock()
+	cached, ok := d.docs[base]
+	d.mu.Unlock()
+
+	if ok && time.Now().Before(cached.expires) {
+		return cached.doc, nil
+	}
+
+	var doc Document
+
+	// The document URL is absolute, so the request bypasses the client API
+	req, err := gohttp.NewRequest(gohttp.MethodGet, base+"/.well-known/openid-configuration", gohttp.NoBody)
+	if err == nil {
+		err = d.c.Do(req, &doc)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("go-auth0: cannot get discovery document for %s: %w", base, err)
+	}
+
+	if strings.TrimRight(doc.Issuer, "/") != base {
+		return nil, fmt.Errorf("%w: %q for %s", ErrIssuerMismatch, doc.Issuer, base)
+	}
+
+	d.mu.Lock()
+	d.docs[base] = cachedDocument{doc: &doc, expires: time.Now().Add(d.ttl)}
+	d.mu.Unlock()
+
+	return &doc, nil
+}
+
+// Invalidate drops the cached document for domain
+func (d *Client) Invalidate(domain string) {
+	base, err := d.BaseURL(domain)
+	if err != nil {
+		return
+	}
+
+	d.mu.Lock()
+	delete(d.docs, base)
+	d.mu.Unlock()
+}
@@ -0,0 +1,91 @@
+package discovery_test
+
+import (
+	"encoding/json"
+	gohttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zenoss/go-auth0/auth0/discovery"
+	"github.com/zenoss/go-auth0/auth0/http"
+)
+
+func TestDiscovery(t *testing.T) {
+	fetches := 0
+	srv := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		assert.Equal(t, "/.well-known/openid-configuration", r.URL.Path)
+		fetches++
+
+		base := "http://" + r.Host
+		_ = json.NewEncoder(w).Encode(discovery.Document{
+			Issuer:                base + "/",
+			AuthorizationEndpoint: base + "/authorize",
+			TokenEndpoint:         base + "/oauth/token",
+			JWKSURI:               base + "/.well-known/jwks.json",
+			RevocationEndpoint:    base + "/oauth/revoke",
+		})
+	}))
+	defer srv.Close()
+
+	d := discovery.New(nil, 0)
+
+	_, err := d.Get(srv.URL)
+	require.ErrorIs(t, err, discovery.ErrInsecureURL)
+
+	d.AllowInsecure = true
+	doc, err := d.Get(srv.URL + "/")
+	require.NoError(t, err)
+	assert.Equal(t, srv.URL+"/oauth/token", doc.TokenEndpoint)
+	assert.Equal(t, srv.URL+"/oauth/revoke", doc.RevocationEndpoint)
+
+	// Cached
+	_, err = d.Get(srv.URL)
+	require.NoError(t, err)
+	assert.Equal(t, 1, fetches)
+
+	d.Invalidate(srv.URL)
+	_, err = d.Get(srv.URL)
+	require.NoError(t, err)
+	assert.Equal(t, 2, fetches)
+}
+
+func TestBaseURL(t *testing.T) {
+	d := discovery.New(nil, 0)
+
+	base, err := d.BaseURL("example.auth0.com")
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.auth0.com", base)
+
+	base, err = d.BaseURL("https://login.example.com/")
+	require.NoError(t, err)
+	assert.Equal(t, "https://login.example.com", base)
+}
+
+func TestDiscoveryWithAPIClient(t *testing.T) {
+	issuer := ""
+	srv := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		assert.Equal(t, "/.well-known/openid-configuration", r.URL.Path)
+		_ = json.NewEncoder(w).Encode(discovery.Document{Issuer: issuer})
+	}))
+	defer srv.Close()
+
+	// The document is fetched from its absolute URL even if the client has an API
+	d := discovery.New(&http.Client{
+		Doer: &http.RootClient{Client: srv.Client()},
+		API:  srv.URL + "/api/v2",
+	}, 0)
+	d.AllowInsecure = true
+
+	issuer = srv.URL + "/"
+	doc, err := d.Get(srv.URL)
+	require.NoError(t, err)
+	assert.Equal(t, srv.URL+"/", doc.Issuer)
+
+	d.Invalidate(srv.URL)
+
+	issuer = "https://attacker.example.com/"
+	_, err = d.Get(srv.URL)
+	require.ErrorIs(t, err, discovery.ErrIssuerMismatch)
+}
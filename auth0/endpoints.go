@@ -0,0 +1,137 @@
+package auth0
+
+import (
+	gohttp "net/http"
+	"net/url"
+	"strings"
+
+	"github.com/zenoss/go-auth0/auth0/discovery"
+	"github.com/zenoss/go-auth0/auth0/http"
+)
+
+// Endpoints are the OAuth2 and OpenID Connect endpoints of an Auth0 tenant
+type Endpoints struct {
+	Issuer                 string
+	AuthURL                string
+	TokenURL               string
+	UserInfoURL            string
+	JWKSURL                string
+	DeviceAuthorizationURL string
+	RevocationURL          string
+}
+
+// DomainEndpoints returns the standard Auth0 endpoints for domain. The domain
+// may also be a base URL such as http://localhost:8080 for test stand-ins.
+func DomainEndpoints(domain string) Endpoints {
+	base := strings.TrimRight(domain, "/")
+	if !strings.Contains(base, "://") {
+		base = "https://" + base
+	}
+
+	return Endpoints{
+		Issuer:                 base + "/",
+		AuthURL:                base + "/authorize",
+		TokenURL:               base + "/oauth/token",
+		UserInfoURL:            base + "/userinfo",
+		JWKSURL:                base + "/.well-known/jwks.json",
+		DeviceAuthorizationURL: base + "/oauth/device/code",
+		RevocationURL:          base + "/oauth/revoke",
+	}
+}
+
+// EndpointsFromDocument returns the endpoints advertised by a discovery document
+func EndpointsFromDocument(doc *discovery.Document) Endpoints {
+	return Endpoints{
+		Issuer:                 doc.Issuer,
+		AuthURL:                doc.AuthorizationEndpoint,
+		TokenURL:               doc.TokenEndpoint,
+		UserInfoURL:            doc.UserinfoEndpoint,
+		JWKSURL:                doc.JWKSURI,
+		DeviceAuthorizationURL: doc.DeviceAuthorizationEndpoint,
+		RevocationURL:          doc.RevocationEndpoint,
+	}
+}
+
+// DiscoverEndpoints loads the endpoints for domain from its
+// /.well-known/openid-configuration document
+func DiscoverEndpoints(client *discovery.Client, domain string) (Endpoints, error) {
+	doc, err := client.Get(domain)
+	if err != nil {
+		return Endpoints{}, err
+	}
+
+	return EndpointsFromDocument(doc), nil
+}
+
+// endpoints returns the endpoints configured on the api, or the standard
+// endpoints for domain if there are none
+func (api API) endpoints(domain string) Endpoints {
+	if api.Endpoints != nil {
+		return *api.Endpoints
+	}
+
+	return DomainEndpoints(domain)
+}
+
+// base returns the tenant base URL, the origin of the issuer or, without one,
+// of the token endpoint
+func (e Endpoints) base() string {
+	if base := origin(e.Issuer); base != "" {
+		return base
+	}
+
+	return origin(e.TokenURL)
+}
+
+// doer returns a Doer which sends requests for the standard token and
+// revocation paths under base to the endpoints, if they are elsewhere
+func (e Endpoints) doer(base string, doer http.Doer) http.Doer {
+	routes := map[string]*url.URL{}
+
+	for path, endpoint := range map[string]string{
+		"/oauth/token":  e.TokenURL,
+		"/oauth/revoke": e.RevocationURL,
+	} {
+		if endpoint == "" || endpoint == base+path {
+			continue
+		}
+
+		if u, err := url.Parse(endpoint); err == nil {
+			routes[path] = u
+		}
+	}
+
+	if len(routes) == 0 {
+		return doer
+	}
+
+	return &routedDoer{Doer: doer, base: base, routes: routes}
+}
+
+// routedDoer sends requests for paths under base to other URLs
+type routedDoer struct {
+	http.Doer
+	base   string
+	routes map[string]*url.URL
+}
+
+// Do sends the request to its route, if it has one
+func (d *routedDoer) Do(req *gohttp.Request, respBody any) error {
+	if u, ok := d.routes[req.URL.Path]; ok && origin(req.URL.String()) == d.base {
+		target := *u
+		req.URL = &target
+		req.Host = target.Host
+	}
+
+	return d.Doer.Do(req, respBody)
+}
+
+// origin returns the scheme and host of rawURL, or "" if it has none
+func origin(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return ""
+	}
+
+	return u.Scheme + "://" + u.Host
+}
@@ -0,0 +1,102 @@
+package auth0_test
+
+import (
+	"encoding/json"
+	gohttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zenoss/go-auth0/auth0"
+	"github.com/zenoss/go-auth0/auth0/discovery"
+	"github.com/zenoss/go-auth0/auth0/http"
+)
+
+func TestDomainEndpoints(t *testing.T) {
+	ep := auth0.DomainEndpoints("example.auth0.com")
+	assert.Equal(t, "https://example.auth0.com/", ep.Issuer)
+	assert.Equal(t, "https://example.auth0.com/oauth/token", ep.TokenURL)
+	assert.Equal(t, "https://example.auth0.com/authorize", ep.AuthURL)
+}
+
+func TestDiscoveredTokenClient(t *testing.T) {
+	mux := gohttp.NewServeMux()
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	// A stand-in tenant that serves tokens from a non-standard path
+	mux.HandleFunc("/.well-known/openid-configuration", func(w gohttp.ResponseWriter, _ *gohttp.Request) {
+		_ = json.NewEncoder(w).Encode(discovery.Document{
+			Issuer:        srv.URL + "/",
+			TokenEndpoint: srv.URL + "/custom/token",
+		})
+	})
+	mux.HandleFunc("/custom/token", func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		var body auth0.TokenRequestBody
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "client_credentials", body.GrantType)
+
+		_ = json.NewEncoder(w).Encode(auth0.TokenResponseBody{AccessToken: "abc", ExpiresIn: 60})
+	})
+
+	d := discovery.New(nil, 0)
+	d.AllowInsecure = true
+
+	ep, err := auth0.DiscoverEndpoints(d, srv.URL)
+	require.NoError(t, err)
+	assert.Equal(t, srv.URL+"/custom/token", ep.TokenURL)
+
+	svc := auth0.TokenClientFromEndpoints(ep)
+	assert.Equal(t, srv.URL, svc.API)
+
+	token, err := svc.GetTokenFromClientCreds("id", "secret", "aud")
+	require.NoError(t, err)
+	assert.Equal(t, "abc", token.AccessToken)
+}
+
+func TestTokenClientEndpointOrigins(t *testing.T) {
+	tokens := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		assert.Equal(t, "/token", r.URL.Path)
+		_ = json.NewEncoder(w).Encode(auth0.TokenResponseBody{AccessToken: "abc"})
+	}))
+	defer tokens.Close()
+
+	tenant := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		assert.Equal(t, "/userinfo", r.URL.Path)
+		_, _ = w.Write([]byte(`{"sub": "auth0|1"}`))
+	}))
+	defer tenant.Close()
+
+	ep := auth0.DomainEndpoints(tenant.URL)
+	ep.TokenURL = tokens.URL + "/token"
+
+	// The token endpoint is on another origin; other requests still go to the tenant
+	svc := auth0.TokenClientFromEndpoints(ep)
+	assert.Equal(t, tenant.URL, svc.API)
+
+	token, err := svc.GetTokenFromClientCreds("id", "secret", "aud")
+	require.NoError(t, err)
+	assert.Equal(t, "abc", token.AccessToken)
+
+	var userInfo map[string]string
+	require.NoError(t, svc.Get("/userinfo", &userInfo))
+	assert.Equal(t, "auth0|1", userInfo["sub"])
+}
+
+func TestTokenServiceLiteral(t *testing.T) {
+	srv := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		assert.Equal(t, "/oauth/token", r.URL.Path)
+		_ = json.NewEncoder(w).Encode(auth0.TokenResponseBody{AccessToken: "abc"})
+	}))
+	defer srv.Close()
+
+	svc := auth0.TokenService{&http.Client{
+		Doer: &http.RootClient{Client: srv.Client()},
+		API:  srv.URL,
+	}}
+
+	token, err := svc.GetTokenFromClientCreds("id", "secret", "aud")
+	require.NoError(t, err)
+	assert.Equal(t, "abc", token.AccessToken)
+}
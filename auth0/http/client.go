@@ -65,13 +65,18 @@ func getResponseError(resp *http.Response) error {
 		return err
 	}
 
+	// OAuth endpoints do not include the status code in the body
+	if respError.StatusCode == 0 {
+		respError.StatusCode = resp.StatusCode
+	}
+
 	return respError
 }
 
 // Do processes a request and unmarshals the response body into respBody
 func (c *RootClient) Do(req *http.Request, respBody any) error {
-	// POSTs are application/json to this api
-	if req.ContentLength > 0 && (req.Method == http.MethodPost ||
+	// POSTs are application/json to this api, unless the caller says otherwise
+	if req.ContentLength > 0 && req.Header.Get("Content-Type") == "" && (req.Method == http.MethodPost ||
 		req.Method == http.MethodPut || req.Method == http.MethodPatch) {
 		req.Header.Add("Content-Type", "application/json")
 	}
@@ -229,6 +234,61 @@ func (c *Client) GetV2(endpoint string, respBody any) error {
 	return c.GetWithHeadersV2(endpoint, respBody, map[string]string{})
 }
 
+// Get performs a get to the endpoint of the API v2 associated with the client,
+// following checkpoint pagination (from/take/next) until all results are read.
+// The endpoint must support checkpoint pagination, e.g. /organizations/{id}/members.
+func (c *Client) GetCheckpointWithHeadersV2(endpoint string, respBody any, headers map[string]string) error {
+	return c.getCheckpoint(endpoint, extractKeyFromEndpoint(noSlash(c.API)+endpoint), respBody, headers)
+}
+
+// Get performs a get to the endpoint of the API v2 associated with the client,
+// following checkpoint pagination until all results are read. keyName is the
+// field of the results, for endpoints where it does not match the path, e.g.
+// "tokens" for /users/{id}/refresh-tokens.
+func (c *Client) GetCheckpointKeyV2(endpoint, keyName string, respBody any) error {
+	return c.getCheckpoint(endpoint, keyName, respBody, map[string]string{})
+}
+
+func (c *Client) getCheckpoint(endpoint, keyName string, respBody any, headers map[string]string) error {
+	// auth0 v2 api returns at most 100 elements per checkpoint page
+	maxTake := 100
+	fullUrl := noSlash(c.API) + endpoint
+
+	var results []any
+
+	from := ""
+
+	for {
+		response, err := makeGetRequest(addCheckpointParams(fullUrl, from, maxTake), headers, c.Doer.Do)
+		if err != nil {
+			return err
+		}
+
+		val, ok := response.(map[string]any)
+		if !ok {
+			return fmt.Errorf("Unable to process response to GET %s query", fullUrl)
+		}
+
+		items, _ := val[keyName].([]any)
+		results = append(results, items...)
+
+		next, _ := val["next"].(string)
+		if next == "" || len(items) == 0 {
+			break
+		}
+
+		from = next
+	}
+
+	return convertResponseData(results, respBody)
+}
+
+// Get performs a get to the endpoint of the API v2 associated with the client,
+// following checkpoint pagination until all results are read.
+func (c *Client) GetCheckpointV2(endpoint string, respBody any) error {
+	return c.GetCheckpointWithHeadersV2(endpoint, respBody, map[string]string{})
+}
+
 // Get performs a get to the endpoint of the API v2 associated with the client,
 // only for the summary, and returns the record count.
 func (c *Client) CountWithHeadersV2(endpoint string, headers map[string]string) (int, error) {
@@ -283,6 +343,31 @@ func (c *Client) Post(endpoint string, body, respBody any) error {
 	return c.PostWithHeaders(endpoint, body, respBody, map[string]string{})
 }
 
+// PostRaw performs a post of body, sent as is with the given content type, to
+// the endpoint of the API associated with the client
+func (c *Client) PostRawWithHeaders(endpoint, contentType string, body io.Reader, respBody any, headers map[string]string) error {
+	req, err := http.NewRequest(http.MethodPost, noSlash(c.API)+endpoint, body)
+	if err != nil {
+		return fmt.Errorf("Cannot create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", contentType)
+
+	for key, value := range headers {
+		if len(strings.TrimSpace(key)) > 0 && len(strings.TrimSpace(value)) > 0 {
+			req.Header.Add(key, value)
+		}
+	}
+
+	return c.Doer.Do(req, respBody)
+}
+
+// PostRaw performs a post of body, sent as is with the given content type, to
+// the endpoint of the API associated with the client
+func (c *Client) PostRaw(endpoint, contentType string, body io.Reader, respBody any) error {
+	return c.PostRawWithHeaders(endpoint, contentType, body, respBody, map[string]string{})
+}
+
 // Put performs a put to the endpoint of the API associated with the client
 func (c *Client) PutWithHeaders(endpoint string, body, respBody any, headers map[string]string) error {
 	data, err := json.Marshal(body)
@@ -396,6 +481,20 @@ func addPagingParams(fullUrl string, page, perPage int) string {
 	return u.String()
 }
 
+func addCheckpointParams(fullUrl, from string, take int) string {
+	u, _ := url.Parse(fullUrl)
+	values, _ := url.ParseQuery(u.RawQuery)
+	values.Set("take", strconv.Itoa(take))
+
+	if from != "" {
+		values.Set("from", from)
+	}
+
+	u.RawQuery = values.Encode()
+
+	return u.String()
+}
+
 func makeGetRequest(fullUrl string, headers map[string]string, requester func(*http.Request, any) error) (any, error) {
 	req, err := http.NewRequest(http.MethodGet, fullUrl, http.NoBody)
 	if err != nil {
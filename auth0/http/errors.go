@@ -1,6 +1,7 @@
 package http
 
 import (
+	"errors"
 	"fmt"
 )
 
@@ -9,6 +10,8 @@ type Error struct {
 	StatusCode int    `json:"statusCode,omitempty"`
 	HTTPError  string `json:"error,omitempty"`
 	Message    string `json:"message,omitempty"`
+	// ErrorDescription is set by the OAuth endpoints, e.g. /oauth/token
+	ErrorDescription string `json:"error_description,omitempty"`
 }
 
 func (e Error) Error() string {
@@ -25,5 +28,25 @@ func (e Error) Error() string {
 		msg += "(" + e.Message + ")"
 	}
 
+	if e.ErrorDescription != "" {
+		msg += "(" + e.ErrorDescription + ")"
+	}
+
 	return msg
 }
+
+// AsError finds the first Error in err's chain, whether it was returned by
+// value or by pointer
+func AsError(err error) (*Error, bool) {
+	var value Error
+	if errors.As(err, &value) {
+		return &value, true
+	}
+
+	var ptr *Error
+	if errors.As(err, &ptr) && ptr != nil {
+		return ptr, true
+	}
+
+	return nil, false
+}
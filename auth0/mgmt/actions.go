@@ -0,0 +1,457 @@
+package mgmt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/go-querystring/query"
+
+	"github.com/zenoss/go-auth0/auth0/http"
+)
+
+// ErrActionBuildFailed is returned by WaitBuilt when an action fails to build
+var ErrActionBuildFailed = errors.New("go-auth0: action build failed")
+
+// DefaultActionPollInterval is how often WaitBuilt checks an action by default
+const DefaultActionPollInterval = time.Second
+
+// Action triggers
+const (
+	TriggerPostLogin                  = "post-login"
+	TriggerCredentialsExchange        = "credentials-exchange"
+	TriggerPreUserRegistration        = "pre-user-registration"
+	TriggerPostUserRegistration       = "post-user-registration"
+	TriggerPostChangePassword         = "post-change-password"
+	TriggerSendPhoneMessage           = "send-phone-message"
+	TriggerPasswordResetPostChallenge = "password-reset-post-challenge"
+)
+
+// Action statuses
+const (
+	ActionStatusPending  = "pending"
+	ActionStatusBuilding = "building"
+	ActionStatusPackaged = "packaged"
+	ActionStatusBuilt    = "built"
+	ActionStatusRetrying = "retrying"
+	ActionStatusFailed   = "failed"
+)
+
+// ActionsService provides a service for action related functions
+type ActionsService struct {
+	c *http.Client
+}
+
+// ActionTrigger is a trigger an action can be bound to
+type ActionTrigger struct {
+	ID      string `json:"id"`
+	Version string `json:"version,omitempty"`
+	Status  string `json:"status,omitempty"`
+}
+
+// ActionDependency is an npm package required by an action
+type ActionDependency struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+// ActionSecret is a secret available to an action. Values are never
+// returned by Auth0.
+type ActionSecret struct {
+	Name      string `json:"name"`
+	Value     string `json:"value,omitempty"`
+	UpdatedAt string `json:"updated_at,omitempty"`
+}
+
+// ActionError is an error building an action
+type ActionError struct {
+	ID      string `json:"id,omitempty"`
+	Message string `json:"msg,omitempty"`
+	URL     string `json:"url,omitempty"`
+}
+
+// Action is an action in Auth0
+type Action struct {
+	ID                 string             `json:"id,omitempty"`
+	Name               string             `json:"name,omitempty"`
+	SupportedTriggers  []ActionTrigger    `json:"supported_triggers,omitempty"`
+	Code               string             `json:"code,omitempty"`
+	Dependencies       []ActionDependency `json:"dependencies,omitempty"`
+	Runtime            string             `json:"runtime,omitempty"`
+	Secrets            []ActionSecret     `json:"secrets,omitempty"`
+	Status             string             `json:"status,omitempty"`
+	DeployedVersion    *ActionVersion     `json:"deployed_version,omitempty"`
+	AllChangesDeployed bool               `json:"all_changes_deployed,omitempty"`
+	CreatedAt          string             `json:"created_at,omitempty"`
+	UpdatedAt          string             `json:"updated_at,omitempty"`
+}
+
+// ActionVersion is an immutable version of an action, created when the
+// action is deployed
+type ActionVersion struct {
+	ID                string             `json:"id,omitempty"`
+	ActionID          string             `json:"action_id,omitempty"`
+	Number            int                `json:"number,omitempty"`
+	Code              string             `json:"code,omitempty"`
+	Dependencies      []ActionDependency `json:"dependencies,omitempty"`
+	Runtime           string             `json:"runtime,omitempty"`
+	Secrets           []ActionSecret     `json:"secrets,omitempty"`
+	SupportedTriggers []ActionTrigger    `json:"supported_triggers,omitempty"`
+	Deployed          bool               `json:"deployed,omitempty"`
+	Status            string             `json:"status,omitempty"`
+	Errors            []ActionError      `json:"errors,omitempty"`
+	BuiltAt           string             `json:"built_at,omitempty"`
+	CreatedAt         string             `json:"created_at,omitempty"`
+	UpdatedAt         string             `json:"updated_at,omitempty"`
+}
+
+// ActionOpts are options which can be used to create an Action
+type ActionOpts struct {
+	Name              string             `json:"name"`
+	SupportedTriggers []ActionTrigger    `json:"supported_triggers"`
+	Code              string             `json:"code,omitempty"`
+	Dependencies      []ActionDependency `json:"dependencies,omitempty"`
+	Runtime           string             `json:"runtime,omitempty"`
+	Secrets           []ActionSecret     `json:"secrets,omitempty"`
+}
+
+// ActionUpdateOpts are options which can be used to update an Action. The
+// change is only live once the action is deployed.
+type ActionUpdateOpts struct {
+	Name              string              `json:"name,omitempty"`
+	SupportedTriggers []ActionTrigger     `json:"supported_triggers,omitempty"`
+	Code              *string             `json:"code,omitempty"`
+	Dependencies      *[]ActionDependency `json:"dependencies,omitempty"`
+	Runtime           string              `json:"runtime,omitempty"`
+	Secrets           *[]ActionSecret     `json:"secrets,omitempty"`
+}
+
+type ActionsPage struct {
+	Total   int      `json:"total,omitempty"`
+	Page    int      `json:"page,omitempty"`
+	PerPage int      `json:"per_page,omitempty"`
+	Actions []Action `json:"actions,omitempty"`
+}
+
+// SearchActionsOpts defines what can be used to search actions
+type SearchActionsOpts struct {
+	TriggerID  string `url:"triggerId,omitempty"`
+	ActionName string `url:"actionName,omitempty"`
+	Deployed   *bool  `url:"deployed,omitempty"`
+	Installed  *bool  `url:"installed,omitempty"`
+	PerPage    int    `url:"per_page,omitempty"`
+	Page       int    `url:"page,omitempty"`
+}
+
+// Encode creates a url.Values encoding of SearchActionsOpts.
+func (opts *SearchActionsOpts) Encode() (string, error) {
+	vals, err := query.Values(opts)
+	if err != nil {
+		return "", err
+	}
+
+	return vals.Encode(), nil
+}
+
+// ActionBindingRef refers to the action of a binding, by ID or by name
+type ActionBindingRef struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// ActionBinding binds an action to a trigger
+type ActionBinding struct {
+	ID          string  `json:"id,omitempty"`
+	TriggerID   string  `json:"trigger_id,omitempty"`
+	DisplayName string  `json:"display_name,omitempty"`
+	Action      *Action `json:"action,omitempty"`
+	CreatedAt   string  `json:"created_at,omitempty"`
+	UpdatedAt   string  `json:"updated_at,omitempty"`
+}
+
+// ActionBindingOpts are options which can be used to bind an action to a
+// trigger
+type ActionBindingOpts struct {
+	Ref         ActionBindingRef `json:"ref"`
+	DisplayName string           `json:"display_name,omitempty"`
+}
+
+// BindActionID returns the options to bind the action with the given ID
+func BindActionID(actionID, displayName string) ActionBindingOpts {
+	return ActionBindingOpts{
+		Ref:         ActionBindingRef{Type: "action_id", Value: actionID},
+		DisplayName: displayName,
+	}
+}
+
+// BindActionName returns the options to bind the action with the given name
+func BindActionName(actionName, displayName string) ActionBindingOpts {
+	return ActionBindingOpts{
+		Ref:         ActionBindingRef{Type: "action_name", Value: actionName},
+		DisplayName: displayName,
+	}
+}
+
+// ActionExecutionResult is the outcome of one action of an execution
+type ActionExecutionResult struct {
+	ActionName string         `json:"action_name,omitempty"`
+	Error      map[string]any `json:"error,omitempty"`
+	StartedAt  string         `json:"started_at,omitempty"`
+	EndedAt    string         `json:"ended_at,omitempty"`
+}
+
+// ActionExecution is the run of the actions bound to a trigger
+type ActionExecution struct {
+	ID        string                  `json:"id,omitempty"`
+	TriggerID string                  `json:"trigger_id,omitempty"`
+	Status    string                  `json:"status,omitempty"`
+	Results   []ActionExecutionResult `json:"results,omitempty"`
+	CreatedAt string                  `json:"created_at,omitempty"`
+	UpdatedAt string                  `json:"updated_at,omitempty"`
+}
+
+// GetAll returns all actions
+func (svc *ActionsService) GetAll() ([]Action, error) {
+	var actions []Action
+
+	err := svc.c.GetV2("/actions/actions", &actions)
+
+	return actions, err
+}
+
+// Get returns an action
+func (svc *ActionsService) Get(actionID string) (Action, error) {
+	var action Action
+
+	err := svc.c.Get("/actions/actions/"+actionID, &action)
+
+	return action, err
+}
+
+// Search retrieves actions according to search criteria
+func (svc *ActionsService) Search(opts SearchActionsOpts) (*ActionsPage, error) {
+	var actionsPage ActionsPage
+
+	queryString, err := opts.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	url := "/actions/actions"
+	if queryString != "" {
+		url = "/actions/actions?" + queryString
+	}
+
+	err = svc.c.Get(url, &actionsPage)
+
+	return &actionsPage, err
+}
+
+// Create creates an action
+func (svc *ActionsService) Create(opts ActionOpts) (Action, error) {
+	var action Action
+
+	err := svc.c.Post("/actions/actions", opts, &action)
+
+	return action, err
+}
+
+// Update updates an action
+func (svc *ActionsService) Update(actionID string, opts ActionUpdateOpts) (Action, error) {
+	var action Action
+
+	err := svc.c.Patch("/actions/actions/"+actionID, &opts, &action)
+
+	return action, err
+}
+
+// Delete deletes an action. With force, the action is also removed from the
+// triggers it is bound to; otherwise deleting a bound action fails.
+func (svc *ActionsService) Delete(actionID string, force bool) error {
+	url := "/actions/actions/" + actionID
+	if force {
+		url += "?force=true"
+	}
+
+	return svc.c.Delete(url, nil, nil)
+}
+
+// WaitBuilt polls an action every interval until it is built or failed to
+// build, or ctx is done. DefaultActionPollInterval is used if interval is
+// not positive. An action must be built before it can be deployed.
+func (svc *ActionsService) WaitBuilt(ctx context.Context, actionID string, interval time.Duration) (Action, error) {
+	if interval <= 0 {
+		interval = DefaultActionPollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		action, err := svc.Get(actionID)
+		if err != nil {
+			return action, err
+		}
+
+		switch action.Status {
+		case ActionStatusBuilt:
+			return action, nil
+		case ActionStatusFailed:
+			return action, fmt.Errorf("%w: %s", ErrActionBuildFailed, action.ID)
+		}
+
+		select {
+		case <-ctx.Done():
+			return action, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Deploy deploys the current code of an action as a new version
+func (svc *ActionsService) Deploy(actionID string) (ActionVersion, error) {
+	var version ActionVersion
+
+	err := svc.c.Post("/actions/actions/"+actionID+"/deploy", struct{}{}, &version)
+
+	return version, err
+}
+
+// GetVersions returns all versions of an action
+func (svc *ActionsService) GetVersions(actionID string) ([]ActionVersion, error) {
+	var versions []ActionVersion
+
+	err := svc.c.GetV2("/actions/actions/"+actionID+"/versions", &versions)
+
+	return versions, err
+}
+
+// GetVersion returns a version of an action
+func (svc *ActionsService) GetVersion(actionID, versionID string) (ActionVersion, error) {
+	var version ActionVersion
+
+	err := svc.c.Get("/actions/actions/"+actionID+"/versions/"+versionID, &version)
+
+	return version, err
+}
+
+// DeployVersion deploys a previous version of an action again, e.g. to roll
+// back a change
+func (svc *ActionsService) DeployVersion(actionID, versionID string) (ActionVersion, error) {
+	var version ActionVersion
+
+	err := svc.c.Post("/actions/actions/"+actionID+"/versions/"+versionID+"/deploy", struct{}{}, &version)
+
+	return version, err
+}
+
+// Test runs the current code of an action with the given event payload, and
+// returns the resulting payload
+func (svc *ActionsService) Test(actionID string, payload map[string]any) (map[string]any, error) {
+	var result struct {
+		Payload map[string]any `json:"payload"`
+	}
+
+	body := struct {
+		Payload map[string]any `json:"payload"`
+	}{Payload: payload}
+
+	err := svc.c.Post("/actions/actions/"+actionID+"/test", &body, &result)
+
+	return result.Payload, err
+}
+
+// GetTriggers returns all triggers
+func (svc *ActionsService) GetTriggers() ([]ActionTrigger, error) {
+	var result struct {
+		Triggers []ActionTrigger `json:"triggers"`
+	}
+
+	err := svc.c.Get("/actions/triggers", &result)
+
+	return result.Triggers, err
+}
+
+// GetBindings returns the actions bound to a trigger, in execution order
+func (svc *ActionsService) GetBindings(triggerID string) ([]ActionBinding, error) {
+	var bindings []ActionBinding
+
+	err := svc.c.GetV2("/actions/triggers/"+triggerID+"/bindings", &bindings)
+
+	return bindings, err
+}
+
+// UpdateBindings replaces the actions bound to a trigger. Actions are
+// executed in the order of bindings.
+func (svc *ActionsService) UpdateBindings(triggerID string, bindings []ActionBindingOpts) ([]ActionBinding, error) {
+	var result struct {
+		Bindings []ActionBinding `json:"bindings"`
+	}
+
+	body := struct {
+		Bindings []ActionBindingOpts `json:"bindings"`
+	}{Bindings: bindings}
+
+	err := svc.c.Patch("/actions/triggers/"+triggerID+"/bindings", &body, &result)
+
+	return result.Bindings, err
+}
+
+// Bind binds an action to a trigger at position (0 is executed first),
+// keeping the other bindings in order. An action already bound to the
+// trigger is moved. A position past the end appends the action.
+func (svc *ActionsService) Bind(triggerID string, position int, binding ActionBindingOpts) ([]ActionBinding, error) {
+	current, err := svc.GetBindings(triggerID)
+	if err != nil {
+		return nil, err
+	}
+
+	bindings := make([]ActionBindingOpts, 0, len(current)+1)
+
+	for _, b := range current {
+		if b.Action == nil || b.Action.ID == "" {
+			continue
+		}
+
+		if (binding.Ref.Type == "action_id" && b.Action.ID == binding.Ref.Value) ||
+			(binding.Ref.Type == "action_name" && b.Action.Name == binding.Ref.Value) {
+			continue
+		}
+
+		bindings = append(bindings, BindActionID(b.Action.ID, b.DisplayName))
+	}
+
+	position = min(max(position, 0), len(bindings))
+	bindings = slices.Insert(bindings, position, binding)
+
+	return svc.UpdateBindings(triggerID, bindings)
+}
+
+// GetExecution returns an execution. The IDs of the executions run for an
+// event are found in its log, see Log.ActionExecutionIDs.
+func (svc *ActionsService) GetExecution(executionID string) (ActionExecution, error) {
+	var execution ActionExecution
+
+	err := svc.c.Get("/actions/executions/"+executionID, &execution)
+
+	return execution, err
+}
+
+// ActionExecutionIDs returns the IDs of the action executions run for the
+// log event. Auth0 has no endpoint listing executions; search the logs for
+// the events of interest instead.
+func (l Log) ActionExecutionIDs() []string {
+	actions, _ := l.Details["actions"].(map[string]any)
+	executions, _ := actions["executions"].([]any)
+
+	ids := make([]string, 0, len(executions))
+	for _, e := range executions {
+		if id, ok := e.(string); ok {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids
+}
@@ -0,0 +1,69 @@
+package mgmt_test
+
+import (
+	"context"
+	gohttp "net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zenoss/go-auth0/auth0/mgmt"
+)
+
+func TestActionsWaitBuiltAndDeploy(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	expectRequest(t, mockDoer, gohttp.MethodGet, "/actions/actions/act_1", `{"id": "act_1", "status": "building"}`)
+	expectRequest(t, mockDoer, gohttp.MethodGet, "/actions/actions/act_1", `{"id": "act_1", "status": "built"}`)
+
+	action, err := svc.Actions.WaitBuilt(context.Background(), "act_1", time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, mgmt.ActionStatusBuilt, action.Status)
+
+	expectRequest(t, mockDoer, gohttp.MethodPost, "/actions/actions/act_1/deploy",
+		`{"id": "ver_1", "action_id": "act_1", "number": 3, "deployed": true}`)
+
+	version, err := svc.Actions.Deploy("act_1")
+	require.NoError(t, err)
+	assert.Equal(t, 3, version.Number)
+
+	expectRequest(t, mockDoer, gohttp.MethodGet, "/actions/actions/act_2",
+		`{"id": "act_2", "status": "failed"}`)
+
+	_, err = svc.Actions.WaitBuilt(context.Background(), "act_2", time.Millisecond)
+	assert.ErrorIs(t, err, mgmt.ErrActionBuildFailed)
+}
+
+func TestActionsBind(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	expectRequest(t, mockDoer, gohttp.MethodGet, "/actions/triggers/post-login/bindings", `{
+		"total": 3,
+		"bindings": [
+			{"id": "b1", "display_name": "first", "action": {"id": "act_1", "name": "first"}},
+			{"id": "b2", "display_name": "mine", "action": {"id": "act_new", "name": "mine"}},
+			{"id": "b3", "display_name": "last", "action": {"id": "act_3", "name": "last"}}
+		]
+	}`)
+	req := expectRequest(t, mockDoer, gohttp.MethodPatch, "/actions/triggers/post-login/bindings",
+		`{"bindings": [{"id": "b4"}, {"id": "b5"}, {"id": "b6"}]}`)
+
+	bindings, err := svc.Actions.Bind(mgmt.TriggerPostLogin, 0, mgmt.BindActionID("act_new", "mine"))
+	require.NoError(t, err)
+	assert.Len(t, bindings, 3)
+	assert.JSONEq(t, `{"bindings": [
+		{"ref": {"type": "action_id", "value": "act_new"}, "display_name": "mine"},
+		{"ref": {"type": "action_id", "value": "act_1"}, "display_name": "first"},
+		{"ref": {"type": "action_id", "value": "act_3"}, "display_name": "last"}
+	]}`, string(req.Body))
+}
+
+func TestLogActionExecutionIDs(t *testing.T) {
+	log := mgmt.Log{Details: map[string]any{
+		"actions": map[string]any{"executions": []any{"exec_1", "exec_2"}},
+	}}
+
+	assert.Equal(t, []string{"exec_1", "exec_2"}, log.ActionExecutionIDs())
+	assert.Empty(t, mgmt.Log{}.ActionExecutionIDs())
+}
@@ -0,0 +1,152 @@
+package mgmt
+
+// Authentication method types
+const (
+	AuthMethodRecoveryCode     = "recovery-code"
+	AuthMethodTOTP             = "totp"
+	AuthMethodPush             = "push"
+	AuthMethodPhone            = "phone"
+	AuthMethodEmail            = "email"
+	AuthMethodWebAuthnRoaming  = "webauthn-roaming"
+	AuthMethodWebAuthnPlatform = "webauthn-platform"
+	AuthMethodGuardian         = "guardian"
+	AuthMethodPasskey          = "passkey"
+	AuthMethodPassword         = "password"
+)
+
+// AuthenticationMethodRef refers to a method grouped under another one, e.g.
+// the push and totp methods of a guardian enrollment
+type AuthenticationMethodRef struct {
+	ID   string `json:"id,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+// AuthenticationMethod is a way a user can authenticate, e.g. an MFA
+// authenticator
+type AuthenticationMethod struct {
+	ID                            string                    `json:"id,omitempty"`
+	Type                          string                    `json:"type,omitempty"`
+	Name                          string                    `json:"name,omitempty"`
+	Confirmed                     bool                      `json:"confirmed,omitempty"`
+	AuthenticationMethods         []AuthenticationMethodRef `json:"authentication_methods,omitempty"`
+	PreferredAuthenticationMethod string                    `json:"preferred_authentication_method,omitempty"`
+	LinkID                        string                    `json:"link_id,omitempty"`
+	PhoneNumber                   string                    `json:"phone_number,omitempty"`
+	Email                         string                    `json:"email,omitempty"`
+	KeyID                         string                    `json:"key_id,omitempty"`
+	PublicKey                     string                    `json:"public_key,omitempty"`
+	RelyingPartyIdentifier        string                    `json:"relying_party_identifier,omitempty"`
+	CreatedAt                     string                    `json:"created_at,omitempty"`
+	EnrolledAt                    string                    `json:"enrolled_at,omitempty"`
+	LastAuthAt                    string                    `json:"last_auth_at,omitempty"`
+}
+
+// AuthenticationMethodOpts are options which can be used to create an
+// AuthenticationMethod. Which fields are required depends on Type, e.g.
+// PhoneNumber for AuthMethodPhone or TOTPSecret for AuthMethodTOTP.
+// PreferredAuthenticationMethod is "sms" or "voice".
+type AuthenticationMethodOpts struct {
+	Type                          string `json:"type"`
+	Name                          string `json:"name,omitempty"`
+	TOTPSecret                    string `json:"totp_secret,omitempty"`
+	PhoneNumber                   string `json:"phone_number,omitempty"`
+	Email                         string `json:"email,omitempty"`
+	PreferredAuthenticationMethod string `json:"preferred_authentication_method,omitempty"`
+	KeyID                         string `json:"key_id,omitempty"`
+	PublicKey                     string `json:"public_key,omitempty"`
+	RelyingPartyIdentifier        string `json:"relying_party_identifier,omitempty"`
+}
+
+// AuthenticationMethodUpdateOpts are options which can be used to update an
+// AuthenticationMethod
+type AuthenticationMethodUpdateOpts struct {
+	Name                          string `json:"name,omitempty"`
+	PreferredAuthenticationMethod string `json:"preferred_authentication_method,omitempty"`
+}
+
+// GetAuthenticationMethods returns the authentication methods of a user
+func (svc *UsersService) GetAuthenticationMethods(userID string) ([]AuthenticationMethod, error) {
+	var methods []AuthenticationMethod
+
+	err := svc.c.Get("/users/"+userID+"/authentication-methods", &methods)
+
+	return methods, err
+}
+
+// GetAuthenticationMethod returns an authentication method of a user
+func (svc *UsersService) GetAuthenticationMethod(userID, methodID string) (AuthenticationMethod, error) {
+	var method AuthenticationMethod
+
+	err := svc.c.Get("/users/"+userID+"/authentication-methods/"+methodID, &method)
+
+	return method, err
+}
+
+// CreateAuthenticationMethod adds an authentication method to a user
+func (svc *UsersService) CreateAuthenticationMethod(userID string, opts AuthenticationMethodOpts) (AuthenticationMethod, error) {
+	var method AuthenticationMethod
+
+	err := svc.c.Post("/users/"+userID+"/authentication-methods", opts, &method)
+
+	return method, err
+}
+
+// ReplaceAuthenticationMethods replaces all authentication methods of a user
+func (svc *UsersService) ReplaceAuthenticationMethods(userID string, opts []AuthenticationMethodOpts) ([]AuthenticationMethod, error) {
+	var methods []AuthenticationMethod
+
+	if opts == nil {
+		opts = []AuthenticationMethodOpts{}
+	}
+
+	err := svc.c.Put("/users/"+userID+"/authentication-methods", opts, &methods)
+
+	return methods, err
+}
+
+// UpdateAuthenticationMethod updates an authentication method of a user
+func (svc *UsersService) UpdateAuthenticationMethod(userID, methodID string, opts AuthenticationMethodUpdateOpts) (AuthenticationMethod, error) {
+	var method AuthenticationMethod
+
+	err := svc.c.Patch("/users/"+userID+"/authentication-methods/"+methodID, &opts, &method)
+
+	return method, err
+}
+
+// DeleteAuthenticationMethod removes an authentication method from a user
+func (svc *UsersService) DeleteAuthenticationMethod(userID, methodID string) error {
+	return svc.c.Delete("/users/"+userID+"/authentication-methods/"+methodID, nil, nil)
+}
+
+// DeleteAllAuthenticationMethods removes all authentication methods of a
+// user, e.g. to reset MFA for a user who lost their device
+func (svc *UsersService) DeleteAllAuthenticationMethods(userID string) error {
+	return svc.c.Delete("/users/"+userID+"/authentication-methods", nil, nil)
+}
+
+// GetEnrollments returns the MFA enrollments of a user
+func (svc *UsersService) GetEnrollments(userID string) ([]Enrollment, error) {
+	var enrollments []Enrollment
+
+	err := svc.c.Get("/users/"+userID+"/enrollments", &enrollments)
+
+	return enrollments, err
+}
+
+// RegenerateRecoveryCode invalidates the MFA recovery code of a user and
+// returns a new one
+func (svc *UsersService) RegenerateRecoveryCode(userID string) (string, error) {
+	var body struct {
+		RecoveryCode string `json:"recovery_code"`
+	}
+
+	err := svc.c.Post("/users/"+userID+"/recovery-code-regeneration", struct{}{}, &body)
+
+	return body.RecoveryCode, err
+}
+
+// InvalidateRememberBrowser makes a user go through MFA again on browsers
+// where they chose to skip it
+func (svc *UsersService) InvalidateRememberBrowser(userID string) error {
+	return svc.c.Post("/users/"+userID+"/multifactor/actions/invalidate-remember-browser", struct{}{}, nil)
+}
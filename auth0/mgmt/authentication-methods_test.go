@@ -0,0 +1,60 @@
+package mgmt_test
+
+import (
+	gohttp "net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zenoss/go-auth0/auth0/mgmt"
+)
+
+func TestUsersAuthenticationMethods(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	expectRequest(t, mockDoer, gohttp.MethodGet, "/users/auth0|user1/authentication-methods", `[
+		{"id": "totp|dev_1", "type": "totp", "confirmed": true},
+		{"id": "guardian|dev_2", "type": "guardian", "authentication_methods": [{"id": "push|dev_2", "type": "push"}]}
+	]`)
+
+	methods, err := svc.Users.GetAuthenticationMethods("auth0|user1")
+	require.NoError(t, err)
+	require.Len(t, methods, 2)
+	assert.Equal(t, mgmt.AuthMethodTOTP, methods[0].Type)
+	assert.Equal(t, mgmt.AuthMethodPush, methods[1].AuthenticationMethods[0].Type)
+
+	req := expectRequest(t, mockDoer, gohttp.MethodPost, "/users/auth0|user1/authentication-methods",
+		`{"id": "phone|dev_3", "type": "phone"}`)
+
+	_, err = svc.Users.CreateAuthenticationMethod("auth0|user1", mgmt.AuthenticationMethodOpts{
+		Type:                          mgmt.AuthMethodPhone,
+		PhoneNumber:                   "+15555550100",
+		PreferredAuthenticationMethod: "sms",
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type": "phone", "phone_number": "+15555550100", "preferred_authentication_method": "sms"}`, string(req.Body))
+
+	expectRequest(t, mockDoer, gohttp.MethodDelete, "/users/auth0|user1/authentication-methods", "")
+	require.NoError(t, svc.Users.DeleteAllAuthenticationMethods("auth0|user1"))
+}
+
+func TestUsersMFAReset(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	expectRequest(t, mockDoer, gohttp.MethodPost, "/users/auth0|user1/recovery-code-regeneration",
+		`{"recovery_code": "ABCD1234"}`)
+
+	code, err := svc.Users.RegenerateRecoveryCode("auth0|user1")
+	require.NoError(t, err)
+	assert.Equal(t, "ABCD1234", code)
+
+	expectRequest(t, mockDoer, gohttp.MethodPost, "/users/auth0|user1/multifactor/actions/invalidate-remember-browser", "")
+	require.NoError(t, svc.Users.InvalidateRememberBrowser("auth0|user1"))
+
+	expectRequest(t, mockDoer, gohttp.MethodGet, "/users/auth0|user1/enrollments",
+		`[{"id": "dev_1", "status": "confirmed", "type": "authenticator"}]`)
+
+	enrollments, err := svc.Users.GetEnrollments("auth0|user1")
+	require.NoError(t, err)
+	assert.Equal(t, "confirmed", enrollments[0].Status)
+}
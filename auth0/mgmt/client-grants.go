@@ -0,0 +1,163 @@
+package mgmt
+
+import (
+	"slices"
+
+	"github.com/google/go-querystring/query"
+
+	"github.com/zenoss/go-auth0/auth0/http"
+)
+
+// ClientGrantsService provides a service for client grant related functions
+type ClientGrantsService struct {
+	c *http.Client
+}
+
+// ClientGrant grants a client access to an API (audience) with a set of scopes
+type ClientGrant struct {
+	ID                   string   `json:"id,omitempty"`
+	ClientID             string   `json:"client_id,omitempty"`
+	Audience             string   `json:"audience,omitempty"`
+	Scope                []string `json:"scope,omitempty"`
+	OrganizationUsage    string   `json:"organization_usage,omitempty"`
+	AllowAnyOrganization bool     `json:"allow_any_organization,omitempty"`
+}
+
+// ClientGrantOpts are options which can be used to create a ClientGrant
+type ClientGrantOpts struct {
+	ClientID             string   `json:"client_id"`
+	Audience             string   `json:"audience"`
+	Scope                []string `json:"scope"`
+	OrganizationUsage    string   `json:"organization_usage,omitempty"`
+	AllowAnyOrganization *bool    `json:"allow_any_organization,omitempty"`
+}
+
+// ClientGrantUpdateOpts are options which can be used to update a ClientGrant
+type ClientGrantUpdateOpts struct {
+	Scope                *[]string `json:"scope,omitempty"`
+	OrganizationUsage    string    `json:"organization_usage,omitempty"`
+	AllowAnyOrganization *bool     `json:"allow_any_organization,omitempty"`
+}
+
+type ClientGrantsPage struct {
+	Start        int           `json:"start,omitempty"`
+	Limit        int           `json:"limit,omitempty"`
+	Length       int           `json:"length,omitempty"`
+	Total        int           `json:"total,omitempty"`
+	ClientGrants []ClientGrant `json:"client_grants,omitempty"`
+}
+
+// SearchClientGrantsOpts defines what can be used to search client grants
+type SearchClientGrantsOpts struct {
+	PerPage              int    `url:"per_page,omitempty"`
+	Page                 int    `url:"page,omitempty"`
+	IncludeTotals        bool   `url:"include_totals,omitempty"`
+	Audience             string `url:"audience,omitempty"`
+	ClientID             string `url:"client_id,omitempty"`
+	AllowAnyOrganization *bool  `url:"allow_any_organization,omitempty"`
+}
+
+// Encode creates a url.Values encoding of SearchClientGrantsOpts.
+func (opts *SearchClientGrantsOpts) Encode() (string, error) {
+	vals, err := query.Values(opts)
+	if err != nil {
+		return "", err
+	}
+
+	return vals.Encode(), nil
+}
+
+// GetAll returns all client grants
+func (svc *ClientGrantsService) GetAll() ([]ClientGrant, error) {
+	var clientGrants []ClientGrant
+
+	err := svc.c.GetV2("/client-grants", &clientGrants)
+
+	return clientGrants, err
+}
+
+// Search retrieves client grants according to search criteria
+func (svc *ClientGrantsService) Search(opts SearchClientGrantsOpts) (*ClientGrantsPage, error) {
+	var clientGrantsPage ClientGrantsPage
+
+	queryString, err := opts.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	url := "/client-grants"
+	if queryString != "" {
+		url = "/client-grants?" + queryString
+	}
+
+	if opts.IncludeTotals {
+		err = svc.c.Get(url, &clientGrantsPage)
+	} else {
+		err = svc.c.Get(url, &clientGrantsPage.ClientGrants)
+	}
+
+	return &clientGrantsPage, err
+}
+
+// Create creates a client grant
+func (svc *ClientGrantsService) Create(opts ClientGrantOpts) (ClientGrant, error) {
+	var clientGrant ClientGrant
+
+	if opts.Scope == nil {
+		opts.Scope = []string{}
+	}
+
+	err := svc.c.Post("/client-grants", opts, &clientGrant)
+
+	return clientGrant, err
+}
+
+// Update updates a client grant
+func (svc *ClientGrantsService) Update(clientGrantID string, opts ClientGrantUpdateOpts) (ClientGrant, error) {
+	var clientGrant ClientGrant
+
+	err := svc.c.Patch("/client-grants/"+clientGrantID, &opts, &clientGrant)
+
+	return clientGrant, err
+}
+
+// Delete deletes a client grant
+func (svc *ClientGrantsService) Delete(clientGrantID string) error {
+	return svc.c.Delete("/client-grants/"+clientGrantID, nil, nil)
+}
+
+// EnsureScopes makes sure the client holds a grant to audience with at least
+// scopes, creating the grant or adding the missing scopes to it as needed.
+// Scopes the grant already has are kept.
+func (svc *ClientGrantsService) EnsureScopes(clientID, audience string, scopes ...string) (ClientGrant, error) {
+	page, err := svc.Search(SearchClientGrantsOpts{
+		ClientID: clientID,
+		Audience: audience,
+	})
+	if err != nil {
+		return ClientGrant{}, err
+	}
+
+	if len(page.ClientGrants) == 0 {
+		return svc.Create(ClientGrantOpts{
+			ClientID: clientID,
+			Audience: audience,
+			Scope:    scopes,
+		})
+	}
+
+	grant := page.ClientGrants[0]
+	merged := slices.Clone(grant.Scope)
+
+	for _, scope := range scopes {
+		if !slices.Contains(merged, scope) {
+			merged = append(merged, scope)
+		}
+	}
+
+	if len(merged) == len(grant.Scope) {
+		return grant, nil
+	}
+
+	return svc.Update(grant.ID, ClientGrantUpdateOpts{Scope: &merged})
+}
@@ -0,0 +1,46 @@
+package mgmt_test
+
+import (
+	gohttp "net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMgmtAudience = "https://example.auth0.com/api/v2/"
+
+func TestClientGrantsEnsureScopesCreates(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	req := expectRequest(t, mockDoer, gohttp.MethodGet, "/client-grants", `[]`)
+	created := expectRequest(t, mockDoer, gohttp.MethodPost, "/client-grants",
+		`{"id": "cg1", "client_id": "abc123", "audience": "`+testMgmtAudience+`", "scope": ["read:users"]}`)
+
+	grant, err := svc.ClientGrants.EnsureScopes("abc123", testMgmtAudience, "read:users")
+	require.NoError(t, err)
+	assert.Equal(t, "cg1", grant.ID)
+	assert.Equal(t, "abc123", req.Query.Get("client_id"))
+	assert.Equal(t, testMgmtAudience, req.Query.Get("audience"))
+	assert.JSONEq(t, `{"client_id": "abc123", "audience": "`+testMgmtAudience+`", "scope": ["read:users"]}`, string(created.Body))
+}
+
+func TestClientGrantsEnsureScopesUpdates(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	existing := `[{"id": "cg1", "client_id": "abc123", "audience": "` + testMgmtAudience + `", "scope": ["read:users", "create:connections"]}]`
+
+	expectRequest(t, mockDoer, gohttp.MethodGet, "/client-grants", existing)
+	req := expectRequest(t, mockDoer, gohttp.MethodPatch, "/client-grants/cg1", `{"id": "cg1"}`)
+
+	_, err := svc.ClientGrants.EnsureScopes("abc123", testMgmtAudience, "create:connections", "update:connections", "delete:connections")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"scope": ["read:users", "create:connections", "update:connections", "delete:connections"]}`, string(req.Body))
+
+	// Nothing to do when the grant already has every scope
+	expectRequest(t, mockDoer, gohttp.MethodGet, "/client-grants", existing)
+
+	grant, err := svc.ClientGrants.EnsureScopes("abc123", testMgmtAudience, "read:users")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"read:users", "create:connections"}, grant.Scope)
+}
@@ -0,0 +1,225 @@
+package mgmt
+
+import (
+	"github.com/google/go-querystring/query"
+
+	"github.com/zenoss/go-auth0/auth0/http"
+)
+
+// ClientsService provides a service for client (application) related functions
+type ClientsService struct {
+	c *http.Client
+}
+
+// JWTConfiguration is the configuration of the JWTs issued to a client
+type JWTConfiguration struct {
+	LifetimeInSeconds int            `json:"lifetime_in_seconds,omitempty"`
+	SecretEncoded     bool           `json:"secret_encoded,omitempty"`
+	Scopes            map[string]any `json:"scopes,omitempty"`
+	Algorithm         string         `json:"alg,omitempty"`
+	Audiences         []string       `json:"audiences,omitempty"`
+}
+
+// RefreshTokenConfiguration configures the refresh tokens issued to a client
+type RefreshTokenConfiguration struct {
+	// RotationType is rotating or non-rotating
+	RotationType string `json:"rotation_type,omitempty"`
+	// ExpirationType is expiring or non-expiring
+	ExpirationType            string `json:"expiration_type,omitempty"`
+	Leeway                    int    `json:"leeway,omitempty"`
+	TokenLifetime             int    `json:"token_lifetime,omitempty"`
+	InfiniteTokenLifetime     *bool  `json:"infinite_token_lifetime,omitempty"`
+	IdleTokenLifetime         int    `json:"idle_token_lifetime,omitempty"`
+	InfiniteIdleTokenLifetime *bool  `json:"infinite_idle_token_lifetime,omitempty"`
+}
+
+// Client is a client (application) in Auth0
+type Client struct {
+	ID                          string                     `json:"client_id,omitempty"`
+	Tenant                      string                     `json:"tenant,omitempty"`
+	Name                        string                     `json:"name,omitempty"`
+	Description                 string                     `json:"description,omitempty"`
+	Global                      bool                       `json:"global,omitempty"`
+	ClientSecret                string                     `json:"client_secret,omitempty"`
+	AppType                     string                     `json:"app_type,omitempty"`
+	LogoURI                     string                     `json:"logo_uri,omitempty"`
+	IsFirstParty                bool                       `json:"is_first_party,omitempty"`
+	OIDCConformant              bool                       `json:"oidc_conformant,omitempty"`
+	Callbacks                   []string                   `json:"callbacks,omitempty"`
+	AllowedOrigins              []string                   `json:"allowed_origins,omitempty"`
+	WebOrigins                  []string                   `json:"web_origins,omitempty"`
+	ClientAliases               []string                   `json:"client_aliases,omitempty"`
+	AllowedClients              []string                   `json:"allowed_clients,omitempty"`
+	AllowedLogoutURLs           []string                   `json:"allowed_logout_urls,omitempty"`
+	GrantTypes                  []string                   `json:"grant_types,omitempty"`
+	JWTConfiguration            *JWTConfiguration          `json:"jwt_configuration,omitempty"`
+	TokenEndpointAuthMethod     string                     `json:"token_endpoint_auth_method,omitempty"`
+	ClientMetadata              map[string]string          `json:"client_metadata,omitempty"`
+	RefreshToken                *RefreshTokenConfiguration `json:"refresh_token,omitempty"`
+	InitiateLoginURI            string                     `json:"initiate_login_uri,omitempty"`
+	SSO                         bool                       `json:"sso,omitempty"`
+	SSODisabled                 bool                       `json:"sso_disabled,omitempty"`
+	CrossOriginAuthentication   bool                       `json:"cross_origin_authentication,omitempty"`
+	OrganizationUsage           string                     `json:"organization_usage,omitempty"`
+	OrganizationRequireBehavior string                     `json:"organization_require_behavior,omitempty"`
+}
+
+// ClientOpts are options which can be used to create a Client
+type ClientOpts struct {
+	Name                        string                     `json:"name"`
+	Description                 string                     `json:"description,omitempty"`
+	AppType                     string                     `json:"app_type,omitempty"`
+	LogoURI                     string                     `json:"logo_uri,omitempty"`
+	IsFirstParty                *bool                      `json:"is_first_party,omitempty"`
+	OIDCConformant              *bool                      `json:"oidc_conformant,omitempty"`
+	Callbacks                   []string                   `json:"callbacks,omitempty"`
+	AllowedOrigins              []string                   `json:"allowed_origins,omitempty"`
+	WebOrigins                  []string                   `json:"web_origins,omitempty"`
+	ClientAliases               []string                   `json:"client_aliases,omitempty"`
+	AllowedClients              []string                   `json:"allowed_clients,omitempty"`
+	AllowedLogoutURLs           []string                   `json:"allowed_logout_urls,omitempty"`
+	GrantTypes                  []string                   `json:"grant_types,omitempty"`
+	JWTConfiguration            *JWTConfiguration          `json:"jwt_configuration,omitempty"`
+	TokenEndpointAuthMethod     string                     `json:"token_endpoint_auth_method,omitempty"`
+	ClientMetadata              map[string]string          `json:"client_metadata,omitempty"`
+	RefreshToken                *RefreshTokenConfiguration `json:"refresh_token,omitempty"`
+	InitiateLoginURI            string                     `json:"initiate_login_uri,omitempty"`
+	SSO                         *bool                      `json:"sso,omitempty"`
+	SSODisabled                 *bool                      `json:"sso_disabled,omitempty"`
+	CrossOriginAuthentication   *bool                      `json:"cross_origin_authentication,omitempty"`
+	OrganizationUsage           string                     `json:"organization_usage,omitempty"`
+	OrganizationRequireBehavior string                     `json:"organization_require_behavior,omitempty"`
+}
+
+// ClientUpdateOpts are options which can be used to update a Client. Fields
+// left nil or empty are not changed. ClientMetadata keys are merged into the
+// client's metadata; a key set to nil is removed.
+type ClientUpdateOpts struct {
+	Name                        string                     `json:"name,omitempty"`
+	Description                 *string                    `json:"description,omitempty"`
+	AppType                     string                     `json:"app_type,omitempty"`
+	LogoURI                     *string                    `json:"logo_uri,omitempty"`
+	IsFirstParty                *bool                      `json:"is_first_party,omitempty"`
+	OIDCConformant              *bool                      `json:"oidc_conformant,omitempty"`
+	Callbacks                   *[]string                  `json:"callbacks,omitempty"`
+	AllowedOrigins              *[]string                  `json:"allowed_origins,omitempty"`
+	WebOrigins                  *[]string                  `json:"web_origins,omitempty"`
+	ClientAliases               *[]string                  `json:"client_aliases,omitempty"`
+	AllowedClients              *[]string                  `json:"allowed_clients,omitempty"`
+	AllowedLogoutURLs           *[]string                  `json:"allowed_logout_urls,omitempty"`
+	GrantTypes                  *[]string                  `json:"grant_types,omitempty"`
+	JWTConfiguration            *JWTConfiguration          `json:"jwt_configuration,omitempty"`
+	TokenEndpointAuthMethod     string                     `json:"token_endpoint_auth_method,omitempty"`
+	ClientMetadata              map[string]*string         `json:"client_metadata,omitempty"`
+	RefreshToken                *RefreshTokenConfiguration `json:"refresh_token,omitempty"`
+	InitiateLoginURI            *string                    `json:"initiate_login_uri,omitempty"`
+	SSO                         *bool                      `json:"sso,omitempty"`
+	SSODisabled                 *bool                      `json:"sso_disabled,omitempty"`
+	CrossOriginAuthentication   *bool                      `json:"cross_origin_authentication,omitempty"`
+	OrganizationUsage           string                     `json:"organization_usage,omitempty"`
+	OrganizationRequireBehavior string                     `json:"organization_require_behavior,omitempty"`
+}
+
+type ClientsPage struct {
+	Start   int      `json:"start,omitempty"`
+	Limit   int      `json:"limit,omitempty"`
+	Length  int      `json:"length,omitempty"`
+	Total   int      `json:"total,omitempty"`
+	Clients []Client `json:"clients,omitempty"`
+}
+
+// SearchClientsOpts defines what can be used to search clients
+type SearchClientsOpts struct {
+	PerPage       int      `url:"per_page,omitempty"`
+	Page          int      `url:"page,omitempty"`
+	IncludeTotals bool     `url:"include_totals,omitempty"`
+	Fields        string   `url:"fields,omitempty"`
+	IncludeFields bool     `url:"include_fields,omitempty"`
+	IsGlobal      *bool    `url:"is_global,omitempty"`
+	IsFirstParty  *bool    `url:"is_first_party,omitempty"`
+	AppType       []string `url:"app_type,omitempty,comma"`
+}
+
+// Encode creates a url.Values encoding of SearchClientsOpts.
+func (opts *SearchClientsOpts) Encode() (string, error) {
+	vals, err := query.Values(opts)
+	if err != nil {
+		return "", err
+	}
+
+	return vals.Encode(), nil
+}
+
+// GetAll returns all clients
+func (svc *ClientsService) GetAll() ([]Client, error) {
+	var clients []Client
+
+	err := svc.c.GetV2("/clients", &clients)
+
+	return clients, err
+}
+
+// Get returns a client
+func (svc *ClientsService) Get(clientID string) (Client, error) {
+	var client Client
+
+	err := svc.c.Get("/clients/"+clientID, &client)
+
+	return client, err
+}
+
+// Search retrieves clients according to search criteria
+func (svc *ClientsService) Search(opts SearchClientsOpts) (*ClientsPage, error) {
+	var clientsPage ClientsPage
+
+	queryString, err := opts.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	url := "/clients"
+	if queryString != "" {
+		url = "/clients?" + queryString
+	}
+
+	if opts.IncludeTotals {
+		err = svc.c.Get(url, &clientsPage)
+	} else {
+		err = svc.c.Get(url, &clientsPage.Clients)
+	}
+
+	return &clientsPage, err
+}
+
+// Create creates a client
+func (svc *ClientsService) Create(opts ClientOpts) (Client, error) {
+	var client Client
+
+	err := svc.c.Post("/clients", opts, &client)
+
+	return client, err
+}
+
+// Update updates a client
+func (svc *ClientsService) Update(clientID string, opts ClientUpdateOpts) (Client, error) {
+	var client Client
+
+	err := svc.c.Patch("/clients/"+clientID, &opts, &client)
+
+	return client, err
+}
+
+// Delete deletes a client
+func (svc *ClientsService) Delete(clientID string) error {
+	return svc.c.Delete("/clients/"+clientID, nil, nil)
+}
+
+// RotateSecret generates a new client secret. The previous secret stops
+// working immediately.
+func (svc *ClientsService) RotateSecret(clientID string) (Client, error) {
+	var client Client
+
+	err := svc.c.Post("/clients/"+clientID+"/rotate-secret", struct{}{}, &client)
+
+	return client, err
+}
@@ -0,0 +1,85 @@
+package mgmt_test
+
+import (
+	gohttp "net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zenoss/go-auth0/auth0/mgmt"
+)
+
+const testClientJSON = `{
+  "client_id": "abc123",
+  "name": "tenant-acme",
+  "app_type": "non_interactive",
+  "callbacks": ["https://acme.example.com/callback"],
+  "grant_types": ["client_credentials"],
+  "jwt_configuration": {"lifetime_in_seconds": 36000, "alg": "RS256"},
+  "refresh_token": {"rotation_type": "rotating", "expiration_type": "expiring", "token_lifetime": 2592000},
+  "client_metadata": {"tenant": "acme"}
+}`
+
+func TestClientsSearch(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	req := expectRequest(t, mockDoer, gohttp.MethodGet, "/clients",
+		`{"start": 0, "limit": 50, "length": 1, "total": 1, "clients": [`+testClientJSON+`]}`)
+
+	page, err := svc.Clients.Search(mgmt.SearchClientsOpts{
+		IncludeTotals: true,
+		PerPage:       50,
+		IsGlobal:      mgmt.Bool(false),
+		AppType:       []string{"non_interactive", "spa"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, page.Total)
+	require.Len(t, page.Clients, 1)
+	assert.Equal(t, "acme", page.Clients[0].ClientMetadata["tenant"])
+	assert.Equal(t, "rotating", page.Clients[0].RefreshToken.RotationType)
+	assert.Equal(t, 36000, page.Clients[0].JWTConfiguration.LifetimeInSeconds)
+
+	assert.Equal(t, "false", req.Query.Get("is_global"))
+	assert.Equal(t, "non_interactive,spa", req.Query.Get("app_type"))
+}
+
+func TestClientsCreateUpdateRotate(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	req := expectRequest(t, mockDoer, gohttp.MethodPost, "/clients", testClientJSON)
+	client, err := svc.Clients.Create(mgmt.ClientOpts{
+		Name:       "tenant-acme",
+		AppType:    "non_interactive",
+		GrantTypes: []string{"client_credentials"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "abc123", client.ID)
+
+	var created map[string]any
+	req.decodeBody(t, &created)
+	assert.Equal(t, "tenant-acme", created["name"])
+
+	req = expectRequest(t, mockDoer, gohttp.MethodPatch, "/clients/abc123", testClientJSON)
+	_, err = svc.Clients.Update(client.ID, mgmt.ClientUpdateOpts{
+		Callbacks:      mgmt.Strings(),
+		SSO:            mgmt.Bool(false),
+		ClientMetadata: map[string]*string{"tenant": mgmt.String("acme"), "old": nil},
+	})
+	require.NoError(t, err)
+
+	var updated map[string]any
+	req.decodeBody(t, &updated)
+	assert.Equal(t, map[string]any{
+		"callbacks":       []any{},
+		"sso":             false,
+		"client_metadata": map[string]any{"tenant": "acme", "old": nil},
+	}, updated)
+
+	expectRequest(t, mockDoer, gohttp.MethodPost, "/clients/abc123/rotate-secret", `{"client_id": "abc123", "client_secret": "new"}`)
+	client, err = svc.Clients.RotateSecret(client.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "new", client.ClientSecret)
+
+	expectRequest(t, mockDoer, gohttp.MethodDelete, "/clients/abc123", "")
+	require.NoError(t, svc.Clients.Delete(client.ID))
+}
@@ -0,0 +1,119 @@
+package mgmt
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+var (
+	// ErrNotDatabaseConnection is returned when reading or updating the
+	// custom scripts of a connection which is not an Auth0 database
+	ErrNotDatabaseConnection = errors.New("go-auth0: not a database connection")
+	// ErrDatabaseCustomizationDisabled is returned when validating the
+	// custom scripts of a database which doesn't use them
+	ErrDatabaseCustomizationDisabled = errors.New("go-auth0: custom database is disabled")
+	// ErrMissingCustomScripts is returned when scripts required by a custom
+	// database are missing
+	ErrMissingCustomScripts = errors.New("go-auth0: missing custom database scripts")
+)
+
+// ValidateCustomScripts checks the scripts required by a custom database are
+// set. In import mode, users are migrated to Auth0 on their first login, and
+// only login and get_user are required. Otherwise the custom database stays
+// the source of truth, and all scripts but get_user and change_email are.
+func (o DatabaseOptions) ValidateCustomScripts() error {
+	if o.EnabledDatabaseCustomization == nil || !*o.EnabledDatabaseCustomization {
+		return ErrDatabaseCustomizationDisabled
+	}
+
+	var scripts DatabaseCustomScripts
+	if o.CustomScripts != nil {
+		scripts = *o.CustomScripts
+	}
+
+	required := map[string]string{
+		"login":    scripts.Login,
+		"get_user": scripts.GetUser,
+	}
+	if o.ImportMode == nil || !*o.ImportMode {
+		required = map[string]string{
+			"login":           scripts.Login,
+			"create":          scripts.Create,
+			"verify":          scripts.Verify,
+			"change_password": scripts.ChangePassword,
+			"delete":          scripts.Delete,
+		}
+	}
+
+	var missing []string
+
+	for _, name := range []string{"login", "get_user", "create", "verify", "change_password", "delete"} {
+		if script, ok := required[name]; ok && strings.TrimSpace(script) == "" {
+			missing = append(missing, name)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: %s", ErrMissingCustomScripts, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// DeleteUserByEmail deletes the user with the given email from a database
+// connection
+func (svc *ConnectionsService) DeleteUserByEmail(connectionID, email string) error {
+	return svc.c.Delete("/connections/"+connectionID+"/users?email="+url.QueryEscape(email), nil, nil)
+}
+
+// GetCustomScripts returns the custom scripts of a database connection
+func (svc *ConnectionsService) GetCustomScripts(connectionID string) (DatabaseCustomScripts, error) {
+	var scripts DatabaseCustomScripts
+
+	options, _, err := svc.getDatabaseOptions(connectionID)
+	if err != nil {
+		return scripts, err
+	}
+
+	if options.CustomScripts != nil {
+		scripts = *options.CustomScripts
+	}
+
+	return scripts, nil
+}
+
+// UpdateCustomScripts replaces the custom scripts of a database connection,
+// keeping its other options
+func (svc *ConnectionsService) UpdateCustomScripts(connectionID string, scripts DatabaseCustomScripts) (Connection, error) {
+	_, connection, err := svc.getDatabaseOptions(connectionID)
+	if err != nil {
+		return connection, err
+	}
+
+	options, err := MergeOptions(connection.Options, DatabaseOptions{CustomScripts: &scripts})
+	if err != nil {
+		return connection, err
+	}
+
+	return svc.Update(connectionID, ConnectionUpdateOpts{Options: options})
+}
+
+func (svc *ConnectionsService) getDatabaseOptions(connectionID string) (*DatabaseOptions, Connection, error) {
+	connection, err := svc.Get(connectionID)
+	if err != nil {
+		return nil, connection, err
+	}
+
+	if connection.Strategy != StrategyAuth0 {
+		return nil, connection, fmt.Errorf("%w: %s", ErrNotDatabaseConnection, connectionID)
+	}
+
+	options, err := connection.DecodeOptions()
+	if err != nil {
+		return nil, connection, err
+	}
+
+	return options.(*DatabaseOptions), connection, nil
+}
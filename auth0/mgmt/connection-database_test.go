@@ -0,0 +1,63 @@
+package mgmt_test
+
+import (
+	gohttp "net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zenoss/go-auth0/auth0/mgmt"
+)
+
+func TestConnectionsDeleteUserByEmail(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	req := expectRequest(t, mockDoer, gohttp.MethodDelete, "/connections/con_1/users", "")
+
+	require.NoError(t, svc.Connections.DeleteUserByEmail("con_1", "someone+tag@example.com"))
+	assert.Equal(t, "someone+tag@example.com", req.Query.Get("email"))
+}
+
+func TestConnectionsUpdateCustomScripts(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	expectRequest(t, mockDoer, gohttp.MethodGet, "/connections/con_1", `{
+		"id": "con_1", "strategy": "auth0",
+		"options": {"import_mode": true, "enabledDatabaseCustomization": true, "customScripts": {"login": "old"}}
+	}`)
+	req := expectRequest(t, mockDoer, gohttp.MethodPatch, "/connections/con_1", `{"id": "con_1", "strategy": "auth0"}`)
+
+	_, err := svc.Connections.UpdateCustomScripts("con_1", mgmt.DatabaseCustomScripts{Login: "new", GetUser: "get"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"options": {
+		"import_mode": true,
+		"enabledDatabaseCustomization": true,
+		"customScripts": {"login": "new", "get_user": "get"}
+	}}`, string(req.Body))
+
+	expectRequest(t, mockDoer, gohttp.MethodGet, "/connections/con_2", `{"id": "con_2", "strategy": "samlp"}`)
+
+	_, err = svc.Connections.GetCustomScripts("con_2")
+	assert.ErrorIs(t, err, mgmt.ErrNotDatabaseConnection)
+}
+
+func TestDatabaseOptionsValidateCustomScripts(t *testing.T) {
+	options := mgmt.DatabaseOptions{
+		EnabledDatabaseCustomization: mgmt.Bool(true),
+		ImportMode:                   mgmt.Bool(true),
+		CustomScripts:                &mgmt.DatabaseCustomScripts{Login: "function login() {}"},
+	}
+
+	err := options.ValidateCustomScripts()
+	assert.ErrorIs(t, err, mgmt.ErrMissingCustomScripts)
+	assert.ErrorContains(t, err, "get_user")
+
+	options.CustomScripts.GetUser = "function getUser() {}"
+	assert.NoError(t, options.ValidateCustomScripts())
+
+	options.ImportMode = mgmt.Bool(false)
+	err = options.ValidateCustomScripts()
+	assert.ErrorContains(t, err, "create, verify, change_password, delete")
+
+	assert.ErrorIs(t, mgmt.DatabaseOptions{}.ValidateCustomScripts(), mgmt.ErrDatabaseCustomizationDisabled)
+}
@@ -0,0 +1,349 @@
+package mgmt
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+)
+
+// ErrUnknownStrategy is returned when decoding the options of a connection
+// strategy this package has no options type for
+var ErrUnknownStrategy = errors.New("go-auth0: unknown connection strategy")
+
+// Connection strategies
+const (
+	StrategyAuth0        = "auth0"
+	StrategySAML         = "samlp"
+	StrategyOIDC         = "oidc"
+	StrategyOkta         = "okta"
+	StrategyAzureAD      = "waad"
+	StrategyGoogleOAuth2 = "google-oauth2"
+	StrategyEmail        = "email"
+	StrategySMS          = "sms"
+	StrategyAD           = "ad"
+)
+
+// Password policies of database connections
+const (
+	PasswordPolicyNone      = "none"
+	PasswordPolicyLow       = "low"
+	PasswordPolicyFair      = "fair"
+	PasswordPolicyGood      = "good"
+	PasswordPolicyExcellent = "excellent"
+)
+
+// ConnectionOptions are the options of a connection, typed for its strategy
+type ConnectionOptions interface {
+	Strategy() string
+}
+
+// OptionsMap returns the options as the map of Connection.Options
+func OptionsMap(options ConnectionOptions) (map[string]any, error) {
+	data, err := json.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+
+	var m map[string]any
+	if err = json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// MergeOptions returns base with the fields set in options replacing its
+// own. Updating a connection replaces all of its options, so merge into the
+// current Connection.Options to keep those the typed options don't model.
+func MergeOptions(base map[string]any, options ConnectionOptions) (map[string]any, error) {
+	m, err := OptionsMap(options)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := maps.Clone(base)
+	if merged == nil {
+		merged = map[string]any{}
+	}
+
+	maps.Copy(merged, m)
+
+	return merged, nil
+}
+
+// SetOptions sets the options, and the strategy they are for
+func (opts *ConnectionOpts) SetOptions(options ConnectionOptions) error {
+	m, err := OptionsMap(options)
+	if err != nil {
+		return err
+	}
+
+	opts.Strategy = options.Strategy()
+	opts.Options = m
+
+	return nil
+}
+
+// DecodeOptions returns the options of the connection as the options type of
+// its strategy, e.g. *DatabaseOptions for StrategyAuth0
+func (c Connection) DecodeOptions() (ConnectionOptions, error) {
+	var options ConnectionOptions
+
+	switch c.Strategy {
+	case StrategyAuth0:
+		options = &DatabaseOptions{}
+	case StrategySAML:
+		options = &SAMLOptions{}
+	case StrategyOIDC:
+		options = &OIDCOptions{}
+	case StrategyOkta:
+		options = &OktaOptions{}
+	case StrategyAzureAD:
+		options = &AzureADOptions{}
+	case StrategyGoogleOAuth2:
+		options = &GoogleOAuth2Options{}
+	case StrategyEmail:
+		options = &EmailOptions{}
+	case StrategySMS:
+		options = &SMSOptions{}
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownStrategy, c.Strategy)
+	}
+
+	data, err := json.Marshal(c.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(data, options); err != nil {
+		return nil, err
+	}
+
+	return options, nil
+}
+
+// PasswordComplexityOptions are the password complexity requirements of a
+// database connection
+type PasswordComplexityOptions struct {
+	MinLength int `json:"min_length,omitempty"`
+}
+
+// PasswordHistoryOptions prevents reusing the last Size passwords
+type PasswordHistoryOptions struct {
+	Enable bool `json:"enable"`
+	Size   int  `json:"size,omitempty"`
+}
+
+// PasswordDictionaryOptions prevents using common passwords, and those of
+// Dictionary
+type PasswordDictionaryOptions struct {
+	Enable     bool     `json:"enable"`
+	Dictionary []string `json:"dictionary,omitempty"`
+}
+
+// PasswordNoPersonalInfoOptions prevents using personal data in passwords
+type PasswordNoPersonalInfoOptions struct {
+	Enable bool `json:"enable"`
+}
+
+// DatabaseCustomScripts are the scripts of a custom database connection
+type DatabaseCustomScripts struct {
+	Login          string `json:"login,omitempty"`
+	GetUser        string `json:"get_user,omitempty"`
+	Create         string `json:"create,omitempty"`
+	Delete         string `json:"delete,omitempty"`
+	Verify         string `json:"verify,omitempty"`
+	ChangePassword string `json:"change_password,omitempty"`
+	ChangeEmail    string `json:"change_email,omitempty"`
+}
+
+// ConnectionMFAOptions are the MFA settings of a connection
+type ConnectionMFAOptions struct {
+	Active               *bool `json:"active,omitempty"`
+	ReturnEnrollSettings *bool `json:"return_enroll_settings,omitempty"`
+}
+
+// DatabaseOptions are the options of an Auth0 database connection
+type DatabaseOptions struct {
+	PasswordPolicy               string                         `json:"passwordPolicy,omitempty"`
+	PasswordComplexityOptions    *PasswordComplexityOptions     `json:"password_complexity_options,omitempty"`
+	PasswordHistory              *PasswordHistoryOptions        `json:"password_history,omitempty"`
+	PasswordDictionary           *PasswordDictionaryOptions     `json:"password_dictionary,omitempty"`
+	PasswordNoPersonalInfo       *PasswordNoPersonalInfoOptions `json:"password_no_personal_info,omitempty"`
+	BruteForceProtection         *bool                          `json:"brute_force_protection,omitempty"`
+	RequiresUsername             *bool                          `json:"requires_username,omitempty"`
+	DisableSignup                *bool                          `json:"disable_signup,omitempty"`
+	EnabledDatabaseCustomization *bool                          `json:"enabledDatabaseCustomization,omitempty"`
+	ImportMode                   *bool                          `json:"import_mode,omitempty"`
+	CustomScripts                *DatabaseCustomScripts         `json:"customScripts,omitempty"`
+	Configuration                map[string]string              `json:"configuration,omitempty"`
+	MFA                          *ConnectionMFAOptions          `json:"mfa,omitempty"`
+}
+
+// Strategy returns StrategyAuth0
+func (DatabaseOptions) Strategy() string { return StrategyAuth0 }
+
+// SAMLIdPInitiatedOptions are the settings of IdP initiated logins
+type SAMLIdPInitiatedOptions struct {
+	Enabled              bool   `json:"enabled"`
+	ClientID             string `json:"client_id,omitempty"`
+	ClientProtocol       string `json:"client_protocol,omitempty"`
+	ClientAuthorizeQuery string `json:"client_authorizequery,omitempty"`
+}
+
+// SAMLOptions are the options of a SAML enterprise connection. SigningCert
+// is the base64 encoded certificate of the identity provider.
+type SAMLOptions struct {
+	SignInEndpoint        string                   `json:"signInEndpoint,omitempty"`
+	SignOutEndpoint       string                   `json:"signOutEndpoint,omitempty"`
+	SigningCert           string                   `json:"signingCert,omitempty"`
+	SignSAMLRequest       *bool                    `json:"signSAMLRequest,omitempty"`
+	SignatureAlgorithm    string                   `json:"signatureAlgorithm,omitempty"`
+	DigestAlgorithm       string                   `json:"digestAlgorithm,omitempty"`
+	ProtocolBinding       string                   `json:"protocolBinding,omitempty"`
+	MetadataURL           string                   `json:"metadataUrl,omitempty"`
+	MetadataXML           string                   `json:"metadataXml,omitempty"`
+	UserIDAttribute       string                   `json:"user_id_attribute,omitempty"`
+	FieldsMap             map[string]any           `json:"fieldsMap,omitempty"`
+	DebugMode             *bool                    `json:"debug,omitempty"`
+	IdPInitiated          *SAMLIdPInitiatedOptions `json:"idpinitiated,omitempty"`
+	TenantDomain          string                   `json:"tenant_domain,omitempty"`
+	DomainAliases         []string                 `json:"domain_aliases,omitempty"`
+	IconURL               string                   `json:"icon_url,omitempty"`
+	SetUserRootAttributes string                   `json:"set_user_root_attributes,omitempty"`
+	NonPersistentAttrs    []string                 `json:"non_persistent_attrs,omitempty"`
+}
+
+// Strategy returns StrategySAML
+func (SAMLOptions) Strategy() string { return StrategySAML }
+
+// OIDCOptions are the options of an OpenID Connect enterprise connection.
+// Type is "front_channel" or "back_channel"; ClientSecret is required for
+// the latter.
+type OIDCOptions struct {
+	Type                  string   `json:"type,omitempty"`
+	ClientID              string   `json:"client_id,omitempty"`
+	ClientSecret          string   `json:"client_secret,omitempty"`
+	Scope                 string   `json:"scope,omitempty"`
+	DiscoveryURL          string   `json:"discovery_url,omitempty"`
+	Issuer                string   `json:"issuer,omitempty"`
+	AuthorizationEndpoint string   `json:"authorization_endpoint,omitempty"`
+	TokenEndpoint         string   `json:"token_endpoint,omitempty"`
+	UserinfoEndpoint      string   `json:"userinfo_endpoint,omitempty"`
+	JWKSURI               string   `json:"jwks_uri,omitempty"`
+	TenantDomain          string   `json:"tenant_domain,omitempty"`
+	DomainAliases         []string `json:"domain_aliases,omitempty"`
+	IconURL               string   `json:"icon_url,omitempty"`
+	SetUserRootAttributes string   `json:"set_user_root_attributes,omitempty"`
+	NonPersistentAttrs    []string `json:"non_persistent_attrs,omitempty"`
+}
+
+// Strategy returns StrategyOIDC
+func (OIDCOptions) Strategy() string { return StrategyOIDC }
+
+// OktaOptions are the options of an Okta Workforce enterprise connection
+type OktaOptions struct {
+	ClientID              string   `json:"client_id,omitempty"`
+	ClientSecret          string   `json:"client_secret,omitempty"`
+	Domain                string   `json:"domain,omitempty"`
+	Scope                 string   `json:"scope,omitempty"`
+	Issuer                string   `json:"issuer,omitempty"`
+	AuthorizationEndpoint string   `json:"authorization_endpoint,omitempty"`
+	TokenEndpoint         string   `json:"token_endpoint,omitempty"`
+	UserinfoEndpoint      string   `json:"userinfo_endpoint,omitempty"`
+	JWKSURI               string   `json:"jwks_uri,omitempty"`
+	DomainAliases         []string `json:"domain_aliases,omitempty"`
+	IconURL               string   `json:"icon_url,omitempty"`
+	SetUserRootAttributes string   `json:"set_user_root_attributes,omitempty"`
+	NonPersistentAttrs    []string `json:"non_persistent_attrs,omitempty"`
+}
+
+// Strategy returns StrategyOkta
+func (OktaOptions) Strategy() string { return StrategyOkta }
+
+// AzureADOptions are the options of a Microsoft Entra ID (Azure AD)
+// enterprise connection
+type AzureADOptions struct {
+	ClientID              string   `json:"client_id,omitempty"`
+	ClientSecret          string   `json:"client_secret,omitempty"`
+	Domain                string   `json:"domain,omitempty"`
+	TenantDomain          string   `json:"tenant_domain,omitempty"`
+	DomainAliases         []string `json:"domain_aliases,omitempty"`
+	WAADProtocol          string   `json:"waad_protocol,omitempty"`
+	UseCommonEndpoint     *bool    `json:"useCommonEndpoint,omitempty"`
+	IdentityAPI           string   `json:"identity_api,omitempty"`
+	MaxGroupsToRetrieve   string   `json:"max_groups_to_retrieve,omitempty"`
+	ExtendedGroups        *bool    `json:"ext_groups,omitempty"`
+	ExtendedProfile       *bool    `json:"ext_profile,omitempty"`
+	BasicProfile          *bool    `json:"basic_profile,omitempty"`
+	APIEnableUsers        *bool    `json:"api_enable_users,omitempty"`
+	IconURL               string   `json:"icon_url,omitempty"`
+	SetUserRootAttributes string   `json:"set_user_root_attributes,omitempty"`
+	NonPersistentAttrs    []string `json:"non_persistent_attrs,omitempty"`
+}
+
+// Strategy returns StrategyAzureAD
+func (AzureADOptions) Strategy() string { return StrategyAzureAD }
+
+// GoogleOAuth2Options are the options of a Google social connection
+type GoogleOAuth2Options struct {
+	ClientID              string   `json:"client_id,omitempty"`
+	ClientSecret          string   `json:"client_secret,omitempty"`
+	AllowedAudiences      []string `json:"allowed_audiences,omitempty"`
+	Scope                 []string `json:"scope,omitempty"`
+	Email                 *bool    `json:"email,omitempty"`
+	Profile               *bool    `json:"profile,omitempty"`
+	SetUserRootAttributes string   `json:"set_user_root_attributes,omitempty"`
+	NonPersistentAttrs    []string `json:"non_persistent_attrs,omitempty"`
+}
+
+// Strategy returns StrategyGoogleOAuth2
+func (GoogleOAuth2Options) Strategy() string { return StrategyGoogleOAuth2 }
+
+// PasswordlessEmail is the email sent by a passwordless email connection
+type PasswordlessEmail struct {
+	From    string `json:"from,omitempty"`
+	Subject string `json:"subject,omitempty"`
+	Syntax  string `json:"syntax,omitempty"`
+	Body    string `json:"body,omitempty"`
+}
+
+// PasswordlessOTP are the settings of one-time passwords
+type PasswordlessOTP struct {
+	TimeStep int `json:"time_step,omitempty"`
+	Length   int `json:"length,omitempty"`
+}
+
+// EmailOptions are the options of a passwordless email connection
+type EmailOptions struct {
+	Name                 string             `json:"name,omitempty"`
+	Email                *PasswordlessEmail `json:"email,omitempty"`
+	OTP                  *PasswordlessOTP   `json:"totp,omitempty"`
+	AuthParams           map[string]any     `json:"authParams,omitempty"`
+	DisableSignup        *bool              `json:"disable_signup,omitempty"`
+	BruteForceProtection *bool              `json:"brute_force_protection,omitempty"`
+}
+
+// Strategy returns StrategyEmail
+func (EmailOptions) Strategy() string { return StrategyEmail }
+
+// SMSOptions are the options of a passwordless SMS connection. Provider is
+// empty for Twilio, or "sms_gateway" with GatewayURL.
+type SMSOptions struct {
+	Name                 string           `json:"name,omitempty"`
+	From                 string           `json:"from,omitempty"`
+	Syntax               string           `json:"syntax,omitempty"`
+	Template             string           `json:"template,omitempty"`
+	OTP                  *PasswordlessOTP `json:"totp,omitempty"`
+	MessagingServiceSID  string           `json:"messaging_service_sid,omitempty"`
+	TwilioSID            string           `json:"twilio_sid,omitempty"`
+	TwilioToken          string           `json:"twilio_token,omitempty"`
+	Provider             string           `json:"provider,omitempty"`
+	GatewayURL           string           `json:"gateway_url,omitempty"`
+	DisableSignup        *bool            `json:"disable_signup,omitempty"`
+	BruteForceProtection *bool            `json:"brute_force_protection,omitempty"`
+}
+
+// Strategy returns StrategySMS
+func (SMSOptions) Strategy() string { return StrategySMS }
@@ -0,0 +1,77 @@
+package mgmt_test
+
+import (
+	gohttp "net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zenoss/go-auth0/auth0/mgmt"
+)
+
+func TestConnectionSetOptions(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	opts := mgmt.ConnectionOpts{Name: "acme-saml"}
+	require.NoError(t, opts.SetOptions(mgmt.SAMLOptions{
+		SignInEndpoint:  "https://idp.example.com/sso",
+		SigningCert:     "LS0t...",
+		SignSAMLRequest: mgmt.Bool(true),
+		DomainAliases:   []string{"acme.com"},
+	}))
+	assert.Equal(t, mgmt.StrategySAML, opts.Strategy)
+
+	req := expectRequest(t, mockDoer, gohttp.MethodPost, "/connections", `{
+		"id": "con_1", "name": "acme-saml", "strategy": "samlp",
+		"options": {"signInEndpoint": "https://idp.example.com/sso", "signSAMLRequest": true, "expires": "2030-01-01"}
+	}`)
+
+	connection, err := svc.Connections.Create(opts)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"name": "acme-saml",
+		"strategy": "samlp",
+		"options": {
+			"signInEndpoint": "https://idp.example.com/sso",
+			"signingCert": "LS0t...",
+			"signSAMLRequest": true,
+			"domain_aliases": ["acme.com"]
+		}
+	}`, string(req.Body))
+
+	options, err := connection.DecodeOptions()
+	require.NoError(t, err)
+	saml := options.(*mgmt.SAMLOptions)
+	assert.Equal(t, "https://idp.example.com/sso", saml.SignInEndpoint)
+
+	// options the typed struct doesn't model survive a merge
+	saml.SignInEndpoint = "https://idp.example.com/sso2"
+	merged, err := mgmt.MergeOptions(connection.Options, saml)
+	require.NoError(t, err)
+	assert.Equal(t, "2030-01-01", merged["expires"])
+	assert.Equal(t, "https://idp.example.com/sso2", merged["signInEndpoint"])
+	assert.Equal(t, "https://idp.example.com/sso", connection.Options["signInEndpoint"])
+}
+
+func TestConnectionDecodeOptions(t *testing.T) {
+	connection := mgmt.Connection{
+		Strategy: mgmt.StrategyAuth0,
+		Options: map[string]any{
+			"passwordPolicy":               "good",
+			"password_history":             map[string]any{"enable": true, "size": 5.0},
+			"enabledDatabaseCustomization": true,
+			"customScripts":                map[string]any{"login": "function login() {}"},
+		},
+	}
+
+	options, err := connection.DecodeOptions()
+	require.NoError(t, err)
+
+	db := options.(*mgmt.DatabaseOptions)
+	assert.Equal(t, mgmt.PasswordPolicyGood, db.PasswordPolicy)
+	assert.Equal(t, 5, db.PasswordHistory.Size)
+	assert.Equal(t, "function login() {}", db.CustomScripts.Login)
+
+	_, err = mgmt.Connection{Strategy: "github"}.DecodeOptions()
+	assert.ErrorIs(t, err, mgmt.ErrUnknownStrategy)
+}
@@ -0,0 +1,165 @@
+package mgmt
+
+// SCIMMapping maps an Auth0 user attribute to a SCIM attribute
+type SCIMMapping struct {
+	Auth0 string `json:"auth0"`
+	SCIM  string `json:"scim"`
+}
+
+// SCIMConfiguration is the SCIM provisioning configuration of a connection
+type SCIMConfiguration struct {
+	ConnectionID    string        `json:"connection_id,omitempty"`
+	ConnectionName  string        `json:"connection_name,omitempty"`
+	Strategy        string        `json:"strategy,omitempty"`
+	TenantName      string        `json:"tenant_name,omitempty"`
+	UserIDAttribute string        `json:"user_id_attribute,omitempty"`
+	Mapping         []SCIMMapping `json:"mapping,omitempty"`
+	CreatedAt       string        `json:"created_at,omitempty"`
+	UpdatedOn       string        `json:"updated_on,omitempty"`
+}
+
+// SCIMConfigurationOpts are options which can be used to create or update a
+// SCIMConfiguration. The default mapping is used if Mapping is empty.
+type SCIMConfigurationOpts struct {
+	UserIDAttribute string        `json:"user_id_attribute,omitempty"`
+	Mapping         []SCIMMapping `json:"mapping,omitempty"`
+}
+
+// SCIMToken is a token the identity provider uses to call the SCIM endpoint.
+// Token is only returned when the token is created.
+type SCIMToken struct {
+	ID         string   `json:"token_id,omitempty"`
+	Token      string   `json:"token,omitempty"`
+	Scopes     []string `json:"scopes,omitempty"`
+	CreatedAt  string   `json:"created_at,omitempty"`
+	ValidUntil string   `json:"valid_until,omitempty"`
+	LastUsedAt string   `json:"last_used_at,omitempty"`
+}
+
+// SCIMTokenOpts are options which can be used to create a SCIMToken.
+// LifetimeSeconds of 0 creates a token which doesn't expire.
+type SCIMTokenOpts struct {
+	Scopes          []string `json:"scopes,omitempty"`
+	LifetimeSeconds int      `json:"token_lifetime,omitempty"`
+}
+
+// EnabledClient is an application enabled for a connection
+type EnabledClient struct {
+	ClientID string `json:"client_id"`
+}
+
+// EnabledClientUpdate enables (Status true) or disables an application for a
+// connection
+type EnabledClientUpdate struct {
+	ClientID string `json:"client_id"`
+	Status   bool   `json:"status"`
+}
+
+// Status checks whether the connector of an AD/LDAP connection is online.
+// nil is returned if it is; Auth0 answers 404 if it is offline.
+func (svc *ConnectionsService) Status(connectionID string) error {
+	var body any
+
+	return svc.c.Get("/connections/"+connectionID+"/status", &body)
+}
+
+// GetSCIMConfiguration returns the SCIM configuration of a connection
+func (svc *ConnectionsService) GetSCIMConfiguration(connectionID string) (SCIMConfiguration, error) {
+	var config SCIMConfiguration
+
+	err := svc.c.Get("/connections/"+connectionID+"/scim-configuration", &config)
+
+	return config, err
+}
+
+// GetDefaultSCIMMapping returns the mapping used when none is given
+func (svc *ConnectionsService) GetDefaultSCIMMapping(connectionID string) ([]SCIMMapping, error) {
+	var body struct {
+		Mapping []SCIMMapping `json:"mapping"`
+	}
+
+	err := svc.c.Get("/connections/"+connectionID+"/scim-configuration/default-mapping", &body)
+
+	return body.Mapping, err
+}
+
+// CreateSCIMConfiguration turns on SCIM provisioning for a connection
+func (svc *ConnectionsService) CreateSCIMConfiguration(connectionID string, opts SCIMConfigurationOpts) (SCIMConfiguration, error) {
+	var config SCIMConfiguration
+
+	err := svc.c.Post("/connections/"+connectionID+"/scim-configuration", opts, &config)
+
+	return config, err
+}
+
+// UpdateSCIMConfiguration updates the SCIM configuration of a connection
+func (svc *ConnectionsService) UpdateSCIMConfiguration(connectionID string, opts SCIMConfigurationOpts) (SCIMConfiguration, error) {
+	var config SCIMConfiguration
+
+	err := svc.c.Patch("/connections/"+connectionID+"/scim-configuration", &opts, &config)
+
+	return config, err
+}
+
+// DeleteSCIMConfiguration turns off SCIM provisioning for a connection
+func (svc *ConnectionsService) DeleteSCIMConfiguration(connectionID string) error {
+	return svc.c.Delete("/connections/"+connectionID+"/scim-configuration", nil, nil)
+}
+
+// GetSCIMTokens returns the SCIM tokens of a connection
+func (svc *ConnectionsService) GetSCIMTokens(connectionID string) ([]SCIMToken, error) {
+	var tokens []SCIMToken
+
+	err := svc.c.Get("/connections/"+connectionID+"/scim-configuration/tokens", &tokens)
+
+	return tokens, err
+}
+
+// CreateSCIMToken creates a SCIM token for a connection
+func (svc *ConnectionsService) CreateSCIMToken(connectionID string, opts SCIMTokenOpts) (SCIMToken, error) {
+	var token SCIMToken
+
+	err := svc.c.Post("/connections/"+connectionID+"/scim-configuration/tokens", opts, &token)
+
+	return token, err
+}
+
+// DeleteSCIMToken revokes a SCIM token of a connection
+func (svc *ConnectionsService) DeleteSCIMToken(connectionID, tokenID string) error {
+	return svc.c.Delete("/connections/"+connectionID+"/scim-configuration/tokens/"+tokenID, nil, nil)
+}
+
+// GetEnabledClients returns the applications enabled for a connection
+func (svc *ConnectionsService) GetEnabledClients(connectionID string) ([]EnabledClient, error) {
+	var clients []EnabledClient
+
+	err := svc.c.GetCheckpointV2("/connections/"+connectionID+"/clients", &clients)
+
+	return clients, err
+}
+
+// UpdateEnabledClients enables or disables applications for a connection.
+// Applications not listed are left as they are, unlike updating
+// EnabledClients with Update, which replaces the whole list.
+func (svc *ConnectionsService) UpdateEnabledClients(connectionID string, updates []EnabledClientUpdate) error {
+	return svc.c.Patch("/connections/"+connectionID+"/clients", updates, nil)
+}
+
+// EnableClients enables applications for a connection
+func (svc *ConnectionsService) EnableClients(connectionID string, clientIDs ...string) error {
+	return svc.UpdateEnabledClients(connectionID, enabledClientUpdates(clientIDs, true))
+}
+
+// DisableClients disables applications for a connection
+func (svc *ConnectionsService) DisableClients(connectionID string, clientIDs ...string) error {
+	return svc.UpdateEnabledClients(connectionID, enabledClientUpdates(clientIDs, false))
+}
+
+func enabledClientUpdates(clientIDs []string, status bool) []EnabledClientUpdate {
+	updates := make([]EnabledClientUpdate, len(clientIDs))
+	for i, id := range clientIDs {
+		updates[i] = EnabledClientUpdate{ClientID: id, Status: status}
+	}
+
+	return updates
+}
@@ -0,0 +1,63 @@
+package mgmt_test
+
+import (
+	gohttp "net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/zenoss/go-auth0/auth0/http"
+	"github.com/zenoss/go-auth0/auth0/mgmt"
+)
+
+func TestConnectionsSCIM(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	req := expectRequest(t, mockDoer, gohttp.MethodPost, "/connections/con_1/scim-configuration",
+		`{"connection_id": "con_1", "user_id_attribute": "externalId", "mapping": [{"auth0": "email", "scim": "emails[primary eq true].value"}]}`)
+
+	config, err := svc.Connections.CreateSCIMConfiguration("con_1", mgmt.SCIMConfigurationOpts{UserIDAttribute: "externalId"})
+	require.NoError(t, err)
+	assert.Equal(t, "email", config.Mapping[0].Auth0)
+	assert.JSONEq(t, `{"user_id_attribute": "externalId"}`, string(req.Body))
+
+	req = expectRequest(t, mockDoer, gohttp.MethodPost, "/connections/con_1/scim-configuration/tokens",
+		`{"token_id": "tok_1", "token": "tok_secret", "scopes": ["get:users", "post:users"]}`)
+
+	token, err := svc.Connections.CreateSCIMToken("con_1", mgmt.SCIMTokenOpts{
+		Scopes:          []string{"get:users", "post:users"},
+		LifetimeSeconds: 86400,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "tok_secret", token.Token)
+	assert.JSONEq(t, `{"scopes": ["get:users", "post:users"], "token_lifetime": 86400}`, string(req.Body))
+}
+
+func TestConnectionsEnabledClients(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	expectRequest(t, mockDoer, gohttp.MethodGet, "/connections/con_1/clients",
+		`{"clients": [{"client_id": "abc"}, {"client_id": "def"}]}`)
+
+	clients, err := svc.Connections.GetEnabledClients("con_1")
+	require.NoError(t, err)
+	assert.Equal(t, []mgmt.EnabledClient{{ClientID: "abc"}, {ClientID: "def"}}, clients)
+
+	req := expectRequest(t, mockDoer, gohttp.MethodPatch, "/connections/con_1/clients", "")
+	require.NoError(t, svc.Connections.DisableClients("con_1", "def"))
+	assert.JSONEq(t, `[{"client_id": "def", "status": false}]`, string(req.Body))
+}
+
+func TestConnectionsStatus(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	mockDoer.On("Do", mock.MatchedBy(func(req *gohttp.Request) bool {
+		return req.URL.Path == "/api/v2/connections/con_1/status"
+	}), mock.Anything).Return(http.Error{StatusCode: 404, Message: "Connection con_1 is offline"}).Once()
+
+	err := svc.Connections.Status("con_1")
+	httpErr, ok := http.AsError(err)
+	require.True(t, ok)
+	assert.Equal(t, 404, httpErr.StatusCode)
+}
@@ -3,9 +3,18 @@ package mgmt
 import (
 	"net/url"
 
+	"github.com/google/go-querystring/query"
+
 	"github.com/zenoss/go-auth0/auth0/http"
 )
 
+// Device credential types
+const (
+	DeviceCredentialPublicKey            = "public_key"
+	DeviceCredentialRefreshToken         = "refresh_token"
+	DeviceCredentialRotatingRefreshToken = "rotating_refresh_token"
+)
+
 type TokenDataOpts struct {
 	ID        string `json:"user_id,omitempty"`
 	TokenType string `json:"type,omitempty"`
@@ -16,6 +25,41 @@ type TokenData struct {
 	ID         string `json:"id,omitempty"`
 	TokenType  string `json:"type,omitempty"`
 	UserID     string `json:"user_id,omitempty"`
+	DeviceID   string `json:"device_id,omitempty"`
+	ClientID   string `json:"client_id,omitempty"`
+	CreatedAt  string `json:"created_at,omitempty"`
+	UpdatedAt  string `json:"updated_at,omitempty"`
+	ExpiresAt  string `json:"expires_at,omitempty"`
+}
+
+// SearchDeviceCredentialsOpts defines what can be used to search device
+// credentials. Type is one of DeviceCredentialPublicKey,
+// DeviceCredentialRefreshToken or DeviceCredentialRotatingRefreshToken.
+type SearchDeviceCredentialsOpts struct {
+	UserID        string `url:"user_id,omitempty"`
+	ClientID      string `url:"client_id,omitempty"`
+	Type          string `url:"type,omitempty"`
+	Fields        string `url:"fields,omitempty"`
+	IncludeFields *bool  `url:"include_fields,omitempty"`
+}
+
+// Encode creates a url.Values encoding of SearchDeviceCredentialsOpts.
+func (opts *SearchDeviceCredentialsOpts) Encode() (string, error) {
+	vals, err := query.Values(opts)
+	if err != nil {
+		return "", err
+	}
+
+	return vals.Encode(), nil
+}
+
+// PublicKeyOpts are options which can be used to register the public key of
+// a device. Value is the base64 encoded PEM of the key.
+type PublicKeyOpts struct {
+	DeviceName string `json:"device_name"`
+	DeviceID   string `json:"device_id"`
+	ClientID   string `json:"client_id,omitempty"`
+	Value      string `json:"value"`
 }
 
 type DeviceCredentials struct {
@@ -48,6 +92,66 @@ func (svc *DeviceCredentials) Count(userID string) (int, error) {
 	return count, err
 }
 
+// Search returns all device credentials matching opts
+func (svc *DeviceCredentials) Search(opts SearchDeviceCredentialsOpts) ([]TokenData, error) {
+	var tokens []TokenData
+
+	queryString, err := opts.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	u := "/device-credentials"
+	if queryString != "" {
+		u += "?" + queryString
+	}
+
+	err = svc.c.GetV2(u, &tokens)
+
+	return tokens, err
+}
+
+// SearchCount counts the device credentials matching opts
+func (svc *DeviceCredentials) SearchCount(opts SearchDeviceCredentialsOpts) (int, error) {
+	queryString, err := opts.Encode()
+	if err != nil {
+		return 0, err
+	}
+
+	u := "/device-credentials"
+	if queryString != "" {
+		u += "?" + queryString
+	}
+
+	return svc.c.CountV2(u)
+}
+
+// CreatePublicKey registers the public key of a device, and returns its ID.
+// Auth0 only accepts this with a token issued to the user owning the device.
+func (svc *DeviceCredentials) CreatePublicKey(opts PublicKeyOpts) (TokenData, error) {
+	var token TokenData
+
+	body := struct {
+		PublicKeyOpts
+		Type string `json:"type"`
+	}{PublicKeyOpts: opts, Type: DeviceCredentialPublicKey}
+
+	err := svc.c.Post("/device-credentials", &body, &token)
+
+	return token, err
+}
+
+// RotatePublicKey registers a new public key for a device, then deletes the
+// previous one. The previous key is kept if the new one can't be registered.
+func (svc *DeviceCredentials) RotatePublicKey(previousID string, opts PublicKeyOpts) (TokenData, error) {
+	token, err := svc.CreatePublicKey(opts)
+	if err != nil {
+		return token, err
+	}
+
+	return token, svc.Delete(previousID)
+}
+
 // Deletes all tokens for the user with a matching device identifier.
 func (svc *DeviceCredentials) DeleteByIdentifierInTokens(_, device string, tokens []TokenData) error {
 	seenTokenId := map[string]bool{}
@@ -0,0 +1,56 @@
+package mgmt_test
+
+import (
+	gohttp "net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zenoss/go-auth0/auth0/mgmt"
+)
+
+func TestDeviceCredentialsSearch(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	req := expectRequest(t, mockDoer, gohttp.MethodGet, "/device-credentials", `{
+		"total": 1,
+		"device_credentials": [{
+			"id": "dcr_1", "type": "public_key", "device_name": "sensor-7", "device_id": "dev-7",
+			"client_id": "abc123", "user_id": "auth0|user1", "created_at": "2026-01-01T00:00:00.000Z"
+		}]
+	}`)
+
+	tokens, err := svc.DeviceCredentials.Search(mgmt.SearchDeviceCredentialsOpts{
+		ClientID: "abc123",
+		Type:     mgmt.DeviceCredentialPublicKey,
+	})
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, "dev-7", tokens[0].DeviceID)
+	assert.Equal(t, "2026-01-01T00:00:00.000Z", tokens[0].CreatedAt)
+	assert.Equal(t, "abc123", req.Query.Get("client_id"))
+	assert.Equal(t, "public_key", req.Query.Get("type"))
+}
+
+func TestDeviceCredentialsRotatePublicKey(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	req := expectRequest(t, mockDoer, gohttp.MethodPost, "/device-credentials", `{"id": "dcr_2"}`)
+	expectRequest(t, mockDoer, gohttp.MethodDelete, "/device-credentials/dcr_1", "")
+
+	token, err := svc.DeviceCredentials.RotatePublicKey("dcr_1", mgmt.PublicKeyOpts{
+		DeviceName: "sensor-7",
+		DeviceID:   "dev-7",
+		ClientID:   "abc123",
+		Value:      "LS0tLS1CRUdJTi...",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "dcr_2", token.ID)
+	assert.JSONEq(t, `{
+		"device_name": "sensor-7",
+		"device_id": "dev-7",
+		"client_id": "abc123",
+		"value": "LS0tLS1CRUdJTi...",
+		"type": "public_key"
+	}`, string(req.Body))
+}
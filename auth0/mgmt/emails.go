@@ -0,0 +1,184 @@
+package mgmt
+
+import (
+	gohttp "net/http"
+
+	"github.com/zenoss/go-auth0/auth0/http"
+)
+
+// EmailProviderService provides a service for email provider related
+// functions
+type EmailProviderService struct {
+	c *http.Client
+}
+
+// EmailTemplatesService provides a service for email template related
+// functions
+type EmailTemplatesService struct {
+	c *http.Client
+}
+
+// Email providers
+const (
+	EmailProviderSMTP     = "smtp"
+	EmailProviderSES      = "ses"
+	EmailProviderSendGrid = "sendgrid"
+	EmailProviderMailgun  = "mailgun"
+)
+
+// EmailTemplateName is the name of an email template
+type EmailTemplateName string
+
+// Email templates
+const (
+	TemplateVerifyEmail       EmailTemplateName = "verify_email"
+	TemplateVerifyEmailByCode EmailTemplateName = "verify_email_by_code"
+	TemplateResetEmail        EmailTemplateName = "reset_email"
+	TemplateResetEmailByCode  EmailTemplateName = "reset_email_by_code"
+	TemplateWelcomeEmail      EmailTemplateName = "welcome_email"
+	TemplateBlockedAccount    EmailTemplateName = "blocked_account"
+	TemplateStolenCredentials EmailTemplateName = "stolen_credentials"
+	TemplateEnrollmentEmail   EmailTemplateName = "enrollment_email"
+	TemplateMFAOOBCode        EmailTemplateName = "mfa_oob_code"
+	TemplateUserInvitation    EmailTemplateName = "user_invitation"
+	TemplateAsyncApproval     EmailTemplateName = "async_approval"
+)
+
+// EmailProviderCredentials are the credentials of an email provider. Which
+// fields are used depends on the provider: SMTP* for EmailProviderSMTP,
+// AccessKeyID, SecretAccessKey and Region for EmailProviderSES, APIKey for
+// EmailProviderSendGrid, and APIKey, Domain and Region for
+// EmailProviderMailgun. Secrets are never returned by Auth0.
+type EmailProviderCredentials struct {
+	SMTPHost        string `json:"smtp_host,omitempty"`
+	SMTPPort        int    `json:"smtp_port,omitempty"`
+	SMTPUser        string `json:"smtp_user,omitempty"`
+	SMTPPass        string `json:"smtp_pass,omitempty"`
+	AccessKeyID     string `json:"accessKeyId,omitempty"`
+	SecretAccessKey string `json:"secretAccessKey,omitempty"`
+	APIKey          string `json:"api_key,omitempty"`
+	Domain          string `json:"domain,omitempty"`
+	Region          string `json:"region,omitempty"`
+}
+
+// EmailProvider is the provider used to send emails
+type EmailProvider struct {
+	Name               string                    `json:"name,omitempty"`
+	Enabled            bool                      `json:"enabled,omitempty"`
+	DefaultFromAddress string                    `json:"default_from_address,omitempty"`
+	Credentials        *EmailProviderCredentials `json:"credentials,omitempty"`
+	Settings           map[string]any            `json:"settings,omitempty"`
+}
+
+// EmailProviderOpts are options which can be used to configure the
+// EmailProvider
+type EmailProviderOpts struct {
+	Name               string                    `json:"name,omitempty"`
+	Enabled            *bool                     `json:"enabled,omitempty"`
+	DefaultFromAddress string                    `json:"default_from_address,omitempty"`
+	Credentials        *EmailProviderCredentials `json:"credentials,omitempty"`
+	Settings           map[string]any            `json:"settings,omitempty"`
+}
+
+// EmailTemplate is an email template
+type EmailTemplate struct {
+	Template               EmailTemplateName `json:"template,omitempty"`
+	Body                   string            `json:"body,omitempty"`
+	From                   string            `json:"from,omitempty"`
+	Subject                string            `json:"subject,omitempty"`
+	Syntax                 string            `json:"syntax,omitempty"`
+	ResultURL              string            `json:"resultUrl,omitempty"`
+	URLLifetimeInSeconds   int               `json:"urlLifetimeInSeconds,omitempty"`
+	IncludeEmailInRedirect bool              `json:"includeEmailInRedirect,omitempty"`
+	Enabled                bool              `json:"enabled,omitempty"`
+}
+
+// EmailTemplateOpts are options which can be used to create or update an
+// EmailTemplate. Syntax defaults to "liquid" on creation.
+type EmailTemplateOpts struct {
+	Template               EmailTemplateName `json:"template,omitempty"`
+	Body                   string            `json:"body,omitempty"`
+	From                   string            `json:"from,omitempty"`
+	Subject                string            `json:"subject,omitempty"`
+	Syntax                 string            `json:"syntax,omitempty"`
+	ResultURL              string            `json:"resultUrl,omitempty"`
+	URLLifetimeInSeconds   *int              `json:"urlLifetimeInSeconds,omitempty"`
+	IncludeEmailInRedirect *bool             `json:"includeEmailInRedirect,omitempty"`
+	Enabled                *bool             `json:"enabled,omitempty"`
+}
+
+// Get returns the email provider
+func (svc *EmailProviderService) Get() (EmailProvider, error) {
+	var provider EmailProvider
+
+	err := svc.c.Get("/emails/provider?fields=name,enabled,default_from_address,credentials,settings", &provider)
+
+	return provider, err
+}
+
+// Create configures the email provider
+func (svc *EmailProviderService) Create(opts EmailProviderOpts) (EmailProvider, error) {
+	var provider EmailProvider
+
+	err := svc.c.Post("/emails/provider", opts, &provider)
+
+	return provider, err
+}
+
+// Update updates the email provider
+func (svc *EmailProviderService) Update(opts EmailProviderOpts) (EmailProvider, error) {
+	var provider EmailProvider
+
+	err := svc.c.Patch("/emails/provider", &opts, &provider)
+
+	return provider, err
+}
+
+// Delete removes the email provider, so emails are sent by Auth0 again
+func (svc *EmailProviderService) Delete() error {
+	return svc.c.Delete("/emails/provider", nil, nil)
+}
+
+// Get returns an email template
+func (svc *EmailTemplatesService) Get(name EmailTemplateName) (EmailTemplate, error) {
+	var template EmailTemplate
+
+	err := svc.c.Get("/email-templates/"+string(name), &template)
+
+	return template, err
+}
+
+// Create creates an email template
+func (svc *EmailTemplatesService) Create(opts EmailTemplateOpts) (EmailTemplate, error) {
+	var template EmailTemplate
+
+	err := svc.c.Post("/email-templates", opts, &template)
+
+	return template, err
+}
+
+// Update updates an email template
+func (svc *EmailTemplatesService) Update(name EmailTemplateName, opts EmailTemplateOpts) (EmailTemplate, error) {
+	var template EmailTemplate
+
+	opts.Template = ""
+	err := svc.c.Patch("/email-templates/"+string(name), &opts, &template)
+
+	return template, err
+}
+
+// Upsert updates an email template, creating it if it doesn't exist yet
+func (svc *EmailTemplatesService) Upsert(name EmailTemplateName, opts EmailTemplateOpts) (EmailTemplate, error) {
+	_, err := svc.Get(name)
+	if err == nil {
+		return svc.Update(name, opts)
+	}
+
+	if httpErr, ok := http.AsError(err); !ok || httpErr.StatusCode != gohttp.StatusNotFound {
+		return EmailTemplate{}, err
+	}
+
+	opts.Template = name
+
+	return svc.Create(opts)
+}
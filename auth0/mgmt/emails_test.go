@@ -0,0 +1,68 @@
+package mgmt_test
+
+import (
+	gohttp "net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/zenoss/go-auth0/auth0/http"
+	"github.com/zenoss/go-auth0/auth0/mgmt"
+)
+
+func TestEmailProviderCreate(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	req := expectRequest(t, mockDoer, gohttp.MethodPost, "/emails/provider",
+		`{"name": "ses", "enabled": true, "default_from_address": "noreply@example.com", "credentials": {"region": "us-east-1"}}`)
+
+	provider, err := svc.EmailProvider.Create(mgmt.EmailProviderOpts{
+		Name:               mgmt.EmailProviderSES,
+		Enabled:            mgmt.Bool(true),
+		DefaultFromAddress: "noreply@example.com",
+		Credentials: &mgmt.EmailProviderCredentials{
+			AccessKeyID:     "AKIA...",
+			SecretAccessKey: "secret",
+			Region:          "us-east-1",
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "us-east-1", provider.Credentials.Region)
+	assert.JSONEq(t, `{
+		"name": "ses",
+		"enabled": true,
+		"default_from_address": "noreply@example.com",
+		"credentials": {"accessKeyId": "AKIA...", "secretAccessKey": "secret", "region": "us-east-1"}
+	}`, string(req.Body))
+}
+
+func TestEmailTemplatesUpsert(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	// the template exists, so it is patched
+	expectRequest(t, mockDoer, gohttp.MethodGet, "/email-templates/verify_email", `{"template": "verify_email"}`)
+	req := expectRequest(t, mockDoer, gohttp.MethodPatch, "/email-templates/verify_email",
+		`{"template": "verify_email", "subject": "Verify your email"}`)
+
+	template, err := svc.EmailTemplates.Upsert(mgmt.TemplateVerifyEmail, mgmt.EmailTemplateOpts{
+		Subject:              "Verify your email",
+		URLLifetimeInSeconds: mgmt.Int(3600),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Verify your email", template.Subject)
+	assert.JSONEq(t, `{"subject": "Verify your email", "urlLifetimeInSeconds": 3600}`, string(req.Body))
+
+	// the template doesn't exist, so it is created
+	mockDoer.On("Do", mock.MatchedBy(func(req *gohttp.Request) bool {
+		return req.Method == gohttp.MethodGet && req.URL.Path == "/api/v2/email-templates/welcome_email"
+	}), mock.Anything).Return(http.Error{StatusCode: 404, Message: "Template not found"}).Once()
+	req = expectRequest(t, mockDoer, gohttp.MethodPost, "/email-templates", `{"template": "welcome_email"}`)
+
+	_, err = svc.EmailTemplates.Upsert(mgmt.TemplateWelcomeEmail, mgmt.EmailTemplateOpts{
+		Body:    "<p>Welcome</p>",
+		Enabled: mgmt.Bool(true),
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"template": "welcome_email", "body": "<p>Welcome</p>", "enabled": true}`, string(req.Body))
+}
@@ -0,0 +1,301 @@
+package mgmt
+
+import (
+	"github.com/zenoss/go-auth0/auth0/http"
+)
+
+// GuardianService provides a service for multi-factor authentication related
+// functions
+type GuardianService struct {
+	c *http.Client
+}
+
+// MFAFactor is the name of a multi-factor authentication factor
+type MFAFactor string
+
+// Multi-factor authentication factors
+const (
+	FactorPushNotification MFAFactor = "push-notification"
+	FactorSMS              MFAFactor = "sms"
+	FactorPhone            MFAFactor = "phone"
+	FactorEmail            MFAFactor = "email"
+	FactorDuo              MFAFactor = "duo"
+	FactorOTP              MFAFactor = "otp"
+	FactorWebAuthnRoaming  MFAFactor = "webauthn-roaming"
+	FactorWebAuthnPlatform MFAFactor = "webauthn-platform"
+	FactorRecoveryCode     MFAFactor = "recovery-code"
+)
+
+// MFA policies. With no policy, MFA is only required when a rule or action
+// asks for it.
+const (
+	MFAPolicyAllApplications = "all-applications"
+	MFAPolicyConfidenceScore = "confidence-score"
+)
+
+// SMS providers
+const (
+	SMSProviderAuth0            = "auth0"
+	SMSProviderTwilio           = "twilio"
+	SMSProviderPhoneMessageHook = "phone-message-hook"
+)
+
+// Push notification providers
+const (
+	PushProviderGuardian = "guardian"
+	PushProviderSNS      = "sns"
+	PushProviderDirect   = "direct"
+)
+
+// Factor is a multi-factor authentication factor of the tenant
+type Factor struct {
+	Name         MFAFactor `json:"name,omitempty"`
+	Enabled      bool      `json:"enabled"`
+	TrialExpired bool      `json:"trial_expired,omitempty"`
+}
+
+// Enrollment is the enrollment of a user in a multi-factor authentication
+// factor
+type Enrollment struct {
+	ID          string `json:"id,omitempty"`
+	Status      string `json:"status,omitempty"`
+	Type        string `json:"type,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Identifier  string `json:"identifier,omitempty"`
+	PhoneNumber string `json:"phone_number,omitempty"`
+	AuthMethod  string `json:"auth_method,omitempty"`
+	EnrolledAt  string `json:"enrolled_at,omitempty"`
+	LastAuth    string `json:"last_auth,omitempty"`
+}
+
+// EnrollmentTicketOpts are options which can be used to create an
+// enrollment ticket. The ticket is emailed to the user when SendMail is set.
+type EnrollmentTicketOpts struct {
+	UserID                   string    `json:"user_id"`
+	Email                    string    `json:"email,omitempty"`
+	SendMail                 *bool     `json:"send_mail,omitempty"`
+	EmailLocale              string    `json:"email_locale,omitempty"`
+	Factor                   MFAFactor `json:"factor,omitempty"`
+	AllowMultipleEnrollments *bool     `json:"allow_multiple_enrollments,omitempty"`
+}
+
+// EnrollmentTicket is a link a user can follow to enroll in MFA
+type EnrollmentTicket struct {
+	ID  string `json:"ticket_id,omitempty"`
+	URL string `json:"ticket_url,omitempty"`
+}
+
+// TwilioProvider is the Twilio configuration used to send SMS
+type TwilioProvider struct {
+	From                string `json:"from,omitempty"`
+	MessagingServiceSID string `json:"messaging_service_sid,omitempty"`
+	AuthToken           string `json:"auth_token,omitempty"`
+	SID                 string `json:"sid,omitempty"`
+}
+
+// PhoneTemplates are the messages sent to users by the phone factor.
+// Templates may use {{code}} and {{tenant.friendly_name}}.
+type PhoneTemplates struct {
+	EnrollmentMessage   string `json:"enrollment_message,omitempty"`
+	VerificationMessage string `json:"verification_message,omitempty"`
+}
+
+// SNSProvider is the Amazon SNS configuration used to send push
+// notifications
+type SNSProvider struct {
+	AWSAccessKeyID                string `json:"aws_access_key_id,omitempty"`
+	AWSSecretAccessKey            string `json:"aws_secret_access_key,omitempty"`
+	AWSRegion                     string `json:"aws_region,omitempty"`
+	SNSAPNSPlatformApplicationARN string `json:"sns_apns_platform_application_arn,omitempty"`
+	SNSGCMPlatformApplicationARN  string `json:"sns_gcm_platform_application_arn,omitempty"`
+}
+
+type providerBody struct {
+	Provider string `json:"provider"`
+}
+
+type messageTypesBody struct {
+	MessageTypes []string `json:"message_types"`
+}
+
+// GetFactors returns all factors and whether they are enabled
+func (svc *GuardianService) GetFactors() ([]Factor, error) {
+	var factors []Factor
+
+	err := svc.c.Get("/guardian/factors", &factors)
+
+	return factors, err
+}
+
+// EnableFactor enables or disables a factor
+func (svc *GuardianService) EnableFactor(name MFAFactor, enabled bool) (Factor, error) {
+	factor := Factor{Name: name}
+	body := struct {
+		Enabled bool `json:"enabled"`
+	}{Enabled: enabled}
+
+	err := svc.c.Put("/guardian/factors/"+string(name), &body, &factor)
+
+	return factor, err
+}
+
+// GetPolicies returns when MFA is required, see MFAPolicyAllApplications
+func (svc *GuardianService) GetPolicies() ([]string, error) {
+	var policies []string
+
+	err := svc.c.Get("/guardian/policies", &policies)
+
+	return policies, err
+}
+
+// UpdatePolicies sets when MFA is required. An empty policies leaves it to
+// rules and actions.
+func (svc *GuardianService) UpdatePolicies(policies []string) ([]string, error) {
+	var result []string
+
+	if policies == nil {
+		policies = []string{}
+	}
+
+	err := svc.c.Put("/guardian/policies", policies, &result)
+
+	return result, err
+}
+
+// CreateEnrollmentTicket creates a ticket a user can follow to enroll in MFA
+func (svc *GuardianService) CreateEnrollmentTicket(opts EnrollmentTicketOpts) (EnrollmentTicket, error) {
+	var ticket EnrollmentTicket
+
+	err := svc.c.Post("/guardian/enrollments/ticket", opts, &ticket)
+
+	return ticket, err
+}
+
+// GetEnrollment returns an enrollment
+func (svc *GuardianService) GetEnrollment(enrollmentID string) (Enrollment, error) {
+	var enrollment Enrollment
+
+	err := svc.c.Get("/guardian/enrollments/"+enrollmentID, &enrollment)
+
+	return enrollment, err
+}
+
+// DeleteEnrollment deletes an enrollment, so the user must enroll again
+func (svc *GuardianService) DeleteEnrollment(enrollmentID string) error {
+	return svc.c.Delete("/guardian/enrollments/"+enrollmentID, nil, nil)
+}
+
+// GetSMSProvider returns the provider used to send SMS, see SMSProviderAuth0
+func (svc *GuardianService) GetSMSProvider() (string, error) {
+	var body providerBody
+
+	err := svc.c.Get("/guardian/factors/phone/selected-provider", &body)
+
+	return body.Provider, err
+}
+
+// SetSMSProvider sets the provider used to send SMS
+func (svc *GuardianService) SetSMSProvider(provider string) (string, error) {
+	body := providerBody{Provider: provider}
+	var result providerBody
+
+	err := svc.c.Put("/guardian/factors/phone/selected-provider", &body, &result)
+
+	return result.Provider, err
+}
+
+// GetTwilioProvider returns the Twilio configuration. AuthToken is not
+// returned.
+func (svc *GuardianService) GetTwilioProvider() (TwilioProvider, error) {
+	var provider TwilioProvider
+
+	err := svc.c.Get("/guardian/factors/phone/providers/twilio", &provider)
+
+	return provider, err
+}
+
+// UpdateTwilioProvider updates the Twilio configuration
+func (svc *GuardianService) UpdateTwilioProvider(provider TwilioProvider) (TwilioProvider, error) {
+	var result TwilioProvider
+
+	err := svc.c.Put("/guardian/factors/phone/providers/twilio", &provider, &result)
+
+	return result, err
+}
+
+// GetPhoneMessageTypes returns how codes are sent to phones, "sms" and/or
+// "voice"
+func (svc *GuardianService) GetPhoneMessageTypes() ([]string, error) {
+	var body messageTypesBody
+
+	err := svc.c.Get("/guardian/factors/phone/message-types", &body)
+
+	return body.MessageTypes, err
+}
+
+// UpdatePhoneMessageTypes sets how codes are sent to phones
+func (svc *GuardianService) UpdatePhoneMessageTypes(messageTypes []string) ([]string, error) {
+	body := messageTypesBody{MessageTypes: messageTypes}
+	var result messageTypesBody
+
+	err := svc.c.Put("/guardian/factors/phone/message-types", &body, &result)
+
+	return result.MessageTypes, err
+}
+
+// GetPhoneTemplates returns the messages sent by the phone factor
+func (svc *GuardianService) GetPhoneTemplates() (PhoneTemplates, error) {
+	var templates PhoneTemplates
+
+	err := svc.c.Get("/guardian/factors/phone/templates", &templates)
+
+	return templates, err
+}
+
+// UpdatePhoneTemplates updates the messages sent by the phone factor
+func (svc *GuardianService) UpdatePhoneTemplates(templates PhoneTemplates) (PhoneTemplates, error) {
+	var result PhoneTemplates
+
+	err := svc.c.Put("/guardian/factors/phone/templates", &templates, &result)
+
+	return result, err
+}
+
+// GetPushProvider returns the provider used to send push notifications, see
+// PushProviderGuardian
+func (svc *GuardianService) GetPushProvider() (string, error) {
+	var body providerBody
+
+	err := svc.c.Get("/guardian/factors/push-notification/selected-provider", &body)
+
+	return body.Provider, err
+}
+
+// SetPushProvider sets the provider used to send push notifications
+func (svc *GuardianService) SetPushProvider(provider string) (string, error) {
+	body := providerBody{Provider: provider}
+	var result providerBody
+
+	err := svc.c.Put("/guardian/factors/push-notification/selected-provider", &body, &result)
+
+	return result.Provider, err
+}
+
+// GetSNSProvider returns the Amazon SNS configuration. AWSSecretAccessKey is
+// not returned.
+func (svc *GuardianService) GetSNSProvider() (SNSProvider, error) {
+	var provider SNSProvider
+
+	err := svc.c.Get("/guardian/factors/push-notification/providers/sns", &provider)
+
+	return provider, err
+}
+
+// UpdateSNSProvider updates the Amazon SNS configuration
+func (svc *GuardianService) UpdateSNSProvider(provider SNSProvider) (SNSProvider, error) {
+	var result SNSProvider
+
+	err := svc.c.Put("/guardian/factors/push-notification/providers/sns", &provider, &result)
+
+	return result, err
+}
@@ -0,0 +1,92 @@
+package mgmt_test
+
+import (
+	"io"
+	gohttp "net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zenoss/go-auth0/auth0/http"
+	"github.com/zenoss/go-auth0/auth0/mgmt"
+)
+
+func TestGuardianFactorsAndPolicies(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	expectRequest(t, mockDoer, gohttp.MethodGet, "/guardian/factors",
+		`[{"name": "otp", "enabled": true}, {"name": "sms", "enabled": false, "trial_expired": true}]`)
+
+	factors, err := svc.Guardian.GetFactors()
+	require.NoError(t, err)
+	assert.Equal(t, []mgmt.Factor{
+		{Name: mgmt.FactorOTP, Enabled: true},
+		{Name: mgmt.FactorSMS, TrialExpired: true},
+	}, factors)
+
+	req := expectRequest(t, mockDoer, gohttp.MethodPut, "/guardian/factors/webauthn-roaming", `{"enabled": true}`)
+
+	factor, err := svc.Guardian.EnableFactor(mgmt.FactorWebAuthnRoaming, true)
+	require.NoError(t, err)
+	assert.Equal(t, mgmt.Factor{Name: mgmt.FactorWebAuthnRoaming, Enabled: true}, factor)
+	assert.JSONEq(t, `{"enabled": true}`, string(req.Body))
+
+	req = expectRequest(t, mockDoer, gohttp.MethodPut, "/guardian/policies", `[]`)
+
+	_, err = svc.Guardian.UpdatePolicies(nil)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[]`, string(req.Body))
+}
+
+func TestGuardianEnrollments(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	req := expectRequest(t, mockDoer, gohttp.MethodPost, "/guardian/enrollments/ticket",
+		`{"ticket_id": "tkt_1", "ticket_url": "https://example.guardian.auth0.com/ticket"}`)
+
+	ticket, err := svc.Guardian.CreateEnrollmentTicket(mgmt.EnrollmentTicketOpts{
+		UserID:   "auth0|user1",
+		SendMail: mgmt.Bool(false),
+		Factor:   mgmt.FactorOTP,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.guardian.auth0.com/ticket", ticket.URL)
+	assert.JSONEq(t, `{"user_id": "auth0|user1", "send_mail": false, "factor": "otp"}`, string(req.Body))
+
+	expectRequest(t, mockDoer, gohttp.MethodDelete, "/guardian/enrollments/dev_1", "")
+	require.NoError(t, svc.Guardian.DeleteEnrollment("dev_1"))
+
+	req = expectRequest(t, mockDoer, gohttp.MethodPut, "/guardian/factors/phone/selected-provider", `{"provider": "twilio"}`)
+
+	provider, err := svc.Guardian.SetSMSProvider(mgmt.SMSProviderTwilio)
+	require.NoError(t, err)
+	assert.Equal(t, mgmt.SMSProviderTwilio, provider)
+	assert.JSONEq(t, `{"provider": "twilio"}`, string(req.Body))
+}
+
+func TestGuardianPutResponses(t *testing.T) {
+	srv := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		assert.Equal(t, gohttp.MethodPut, r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.Copy(w, r.Body)
+	}))
+	t.Cleanup(srv.Close)
+
+	svc := mgmt.New(&http.Client{
+		Doer: &http.RootClient{Client: srv.Client()},
+		API:  srv.URL + "/api/v2",
+	})
+
+	provider, err := svc.Guardian.SetSMSProvider(mgmt.SMSProviderTwilio)
+	require.NoError(t, err)
+	assert.Equal(t, mgmt.SMSProviderTwilio, provider)
+
+	provider, err = svc.Guardian.SetPushProvider(mgmt.PushProviderSNS)
+	require.NoError(t, err)
+	assert.Equal(t, mgmt.PushProviderSNS, provider)
+
+	messageTypes, err := svc.Guardian.UpdatePhoneMessageTypes([]string{"sms", "voice"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sms", "voice"}, messageTypes)
+}
@@ -0,0 +1,366 @@
+package mgmt
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"mime/multipart"
+	gohttp "net/http"
+	"strconv"
+	"time"
+
+	"github.com/zenoss/go-auth0/auth0/http"
+)
+
+var (
+	// ErrJobFailed is returned by Wait when a job ends in failure
+	ErrJobFailed = errors.New("go-auth0: job failed")
+	// ErrNoExportLocation is returned when downloading the export of a job
+	// which has no file to download
+	ErrNoExportLocation = errors.New("go-auth0: job has no export location")
+	// ErrExportDownload is returned when the export file can't be downloaded
+	ErrExportDownload = errors.New("go-auth0: cannot download export")
+	// ErrUsersImportTooLarge is returned when the users of an import don't
+	// fit in a single users file
+	ErrUsersImportTooLarge = errors.New("go-auth0: users import file is too large")
+)
+
+// Job statuses
+const (
+	JobStatusPending    = "pending"
+	JobStatusProcessing = "processing"
+	JobStatusCompleted  = "completed"
+	JobStatusFailed     = "failed"
+)
+
+// Users export formats
+const (
+	ExportFormatCSV  = "csv"
+	ExportFormatJSON = "json"
+)
+
+// DefaultJobPollInterval is how often Wait checks a job by default
+const DefaultJobPollInterval = 2 * time.Second
+
+// MaxUsersImportSize is the largest users file Auth0 accepts for an import
+const MaxUsersImportSize = 500 * 1024
+
+// JobsService provides a service for job related functions
+type JobsService struct {
+	c *http.Client
+
+	// Downloader is used to download export files, which are not served by
+	// the Management API. http.DefaultClient is used if nil.
+	Downloader *gohttp.Client
+}
+
+// JobSummary is the outcome of a users import
+type JobSummary struct {
+	Failed   int `json:"failed"`
+	Updated  int `json:"updated"`
+	Inserted int `json:"inserted"`
+	Total    int `json:"total"`
+}
+
+// Job is a job in Auth0
+type Job struct {
+	ID              string            `json:"id,omitempty"`
+	Type            string            `json:"type,omitempty"`
+	Status          string            `json:"status,omitempty"`
+	CreatedAt       string            `json:"created_at,omitempty"`
+	ConnectionID    string            `json:"connection_id,omitempty"`
+	Connection      string            `json:"connection,omitempty"`
+	ExternalID      string            `json:"external_id,omitempty"`
+	Location        string            `json:"location,omitempty"`
+	PercentageDone  int               `json:"percentage_done,omitempty"`
+	TimeLeftSeconds int               `json:"time_left_seconds,omitempty"`
+	Format          string            `json:"format,omitempty"`
+	Limit           int               `json:"limit,omitempty"`
+	Fields          []UserExportField `json:"fields,omitempty"`
+	Summary         *JobSummary       `json:"summary,omitempty"`
+}
+
+// Done tells whether the job completed or failed
+func (j Job) Done() bool {
+	return j.Status == JobStatusCompleted || j.Status == JobStatusFailed
+}
+
+// ImportUser is a user record of a users import. See the Auth0 bulk import
+// schema for the accepted fields.
+type ImportUser struct {
+	ID                 string         `json:"user_id,omitempty"`
+	Email              string         `json:"email,omitempty"`
+	EmailVerified      bool           `json:"email_verified,omitempty"`
+	Username           string         `json:"username,omitempty"`
+	GivenName          string         `json:"given_name,omitempty"`
+	FamilyName         string         `json:"family_name,omitempty"`
+	Name               string         `json:"name,omitempty"`
+	Nickname           string         `json:"nickname,omitempty"`
+	Picture            string         `json:"picture,omitempty"`
+	Blocked            bool           `json:"blocked,omitempty"`
+	AppMetadata        map[string]any `json:"app_metadata,omitempty"`
+	UserMetadata       map[string]any `json:"user_metadata,omitempty"`
+	PasswordHash       string         `json:"password_hash,omitempty"`
+	CustomPasswordHash map[string]any `json:"custom_password_hash,omitempty"`
+}
+
+// ImportUserFromOpts returns the import record of a user described as for
+// UsersService.Create. Passwords can't be imported in clear text, so
+// opts.Password is dropped; set PasswordHash instead.
+func ImportUserFromOpts(opts UserOpts) ImportUser {
+	return ImportUser{
+		ID:            opts.ID,
+		Email:         opts.Email,
+		EmailVerified: opts.EmailVerified,
+		Username:      opts.Username,
+		GivenName:     opts.GivenName,
+		FamilyName:    opts.FamilyName,
+		AppMetadata:   opts.AppMetadata,
+		UserMetadata:  opts.UserMetadata,
+	}
+}
+
+// UsersImportOpts are options which can be used to import users.
+// SendCompletionEmail defaults to true in Auth0.
+type UsersImportOpts struct {
+	ConnectionID        string
+	Upsert              bool
+	ExternalID          string
+	SendCompletionEmail *bool
+}
+
+// UserExportField is a user field to export, optionally renamed
+type UserExportField struct {
+	Name     string `json:"name"`
+	ExportAs string `json:"export_as,omitempty"`
+}
+
+// UsersExportOpts are options which can be used to export users. All
+// connections are exported if ConnectionID is empty.
+type UsersExportOpts struct {
+	ConnectionID string            `json:"connection_id,omitempty"`
+	Format       string            `json:"format,omitempty"`
+	Limit        int               `json:"limit,omitempty"`
+	Fields       []UserExportField `json:"fields,omitempty"`
+}
+
+// JobErrorDetail is why a record of a users import was rejected
+type JobErrorDetail struct {
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+	Path    string `json:"path,omitempty"`
+}
+
+// JobError is a record of a users import which was rejected
+type JobError struct {
+	User   map[string]any   `json:"user,omitempty"`
+	Errors []JobErrorDetail `json:"errors,omitempty"`
+}
+
+// Get returns a job
+func (svc *JobsService) Get(jobID string) (Job, error) {
+	var job Job
+
+	err := svc.c.Get("/jobs/"+jobID, &job)
+
+	return job, err
+}
+
+// GetErrors returns the records rejected by a users import
+func (svc *JobsService) GetErrors(jobID string) ([]JobError, error) {
+	var jobErrors []JobError
+
+	err := svc.c.Get("/jobs/"+jobID+"/errors", &jobErrors)
+
+	return jobErrors, err
+}
+
+// ImportUsers starts importing users into a database connection. Use
+// slices.Values to import a slice of users. The users are sent as one file,
+// which Auth0 limits to MaxUsersImportSize bytes; if they don't fit,
+// ErrUsersImportTooLarge is returned before anything is uploaded, and the
+// users should be split across several imports.
+func (svc *JobsService) ImportUsers(opts UsersImportOpts, users iter.Seq[ImportUser]) (Job, error) {
+	var job Job
+
+	body := &bytes.Buffer{}
+	form := multipart.NewWriter(body)
+
+	file, err := form.CreateFormFile("users", "users.json")
+	if err != nil {
+		return job, err
+	}
+
+	// The size is checked while writing, so oversized input stops being read early
+	if err = writeImportUsers(&limitedWriter{w: file, n: MaxUsersImportSize}, users); err != nil {
+		return job, err
+	}
+
+	fields := [][2]string{
+		{"connection_id", opts.ConnectionID},
+		{"upsert", strconv.FormatBool(opts.Upsert)},
+		{"external_id", opts.ExternalID},
+	}
+	if opts.SendCompletionEmail != nil {
+		fields = append(fields, [2]string{"send_completion_email", strconv.FormatBool(*opts.SendCompletionEmail)})
+	}
+
+	for _, field := range fields {
+		if field[1] == "" {
+			continue
+		}
+
+		if err = form.WriteField(field[0], field[1]); err != nil {
+			return job, err
+		}
+	}
+
+	if err = form.Close(); err != nil {
+		return job, err
+	}
+
+	err = svc.c.PostRaw("/jobs/users-imports", form.FormDataContentType(), body, &job)
+
+	return job, err
+}
+
+// limitedWriter fails with ErrUsersImportTooLarge once more than n bytes
+// are written
+type limitedWriter struct {
+	w io.Writer
+	n int
+}
+
+func (lw *limitedWriter) Write(p []byte) (int, error) {
+	if len(p) > lw.n {
+		return 0, fmt.Errorf("%w: more than %d bytes", ErrUsersImportTooLarge, MaxUsersImportSize)
+	}
+
+	lw.n -= len(p)
+
+	return lw.w.Write(p)
+}
+
+// writeImportUsers writes users as a JSON array
+func writeImportUsers(w io.Writer, users iter.Seq[ImportUser]) error {
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+
+	first := true
+
+	for user := range users {
+		if !first {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+
+		first = false
+
+		data, err := json.Marshal(user)
+		if err != nil {
+			return err
+		}
+
+		if _, err = w.Write(data); err != nil {
+			return err
+		}
+	}
+
+	_, err := io.WriteString(w, "]")
+
+	return err
+}
+
+// ExportUsers starts exporting users
+func (svc *JobsService) ExportUsers(opts UsersExportOpts) (Job, error) {
+	var job Job
+
+	err := svc.c.Post("/jobs/users-exports", opts, &job)
+
+	return job, err
+}
+
+// Wait polls a job every interval until it completes or fails, or ctx is
+// done. DefaultJobPollInterval is used if interval is not positive. The
+// last state of the job is returned, with ErrJobFailed if it failed.
+func (svc *JobsService) Wait(ctx context.Context, jobID string, interval time.Duration) (Job, error) {
+	if interval <= 0 {
+		interval = DefaultJobPollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		job, err := svc.Get(jobID)
+		if err != nil {
+			return job, err
+		}
+
+		switch job.Status {
+		case JobStatusCompleted:
+			return job, nil
+		case JobStatusFailed:
+			return job, fmt.Errorf("%w: %s", ErrJobFailed, job.ID)
+		}
+
+		select {
+		case <-ctx.Done():
+			return job, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// DownloadExport writes the decompressed export file of a completed users
+// export to w
+func (svc *JobsService) DownloadExport(ctx context.Context, job Job, w io.Writer) error {
+	if job.Location == "" {
+		return fmt.Errorf("%w: %s", ErrNoExportLocation, job.ID)
+	}
+
+	req, err := gohttp.NewRequestWithContext(ctx, gohttp.MethodGet, job.Location, gohttp.NoBody)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrExportDownload, err)
+	}
+
+	client := svc.Downloader
+	if client == nil {
+		client = gohttp.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrExportDownload, err)
+	}
+
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != gohttp.StatusOK {
+		return fmt.Errorf("%w: %s", ErrExportDownload, resp.Status)
+	}
+
+	gz, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrExportDownload, err)
+	}
+
+	defer func() {
+		_ = gz.Close()
+	}()
+
+	if _, err = io.Copy(w, gz); err != nil {
+		return fmt.Errorf("%w: %w", ErrExportDownload, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package mgmt_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	gohttp "net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zenoss/go-auth0/auth0/mgmt"
+)
+
+func TestJobsImportUsers(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	req := expectRequest(t, mockDoer, gohttp.MethodPost, "/jobs/users-imports",
+		`{"id": "job_1", "type": "users_import", "status": "pending"}`)
+
+	users := []mgmt.ImportUser{
+		mgmt.ImportUserFromOpts(mgmt.UserOpts{Email: "one@example.com", Password: "dropped"}),
+		{Email: "two@example.com", PasswordHash: "$2b$10$hash"},
+	}
+
+	job, err := svc.Jobs.ImportUsers(mgmt.UsersImportOpts{
+		ConnectionID:        "con_1",
+		Upsert:              true,
+		SendCompletionEmail: mgmt.Bool(false),
+	}, slices.Values(users))
+	require.NoError(t, err)
+
+	assert.Equal(t, "job_1", job.ID)
+
+	mediaType, params, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/form-data", mediaType)
+
+	form, err := multipart.NewReader(bytes.NewReader(req.Body), params["boundary"]).ReadForm(1 << 20)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"con_1"}, form.Value["connection_id"])
+	assert.Equal(t, []string{"true"}, form.Value["upsert"])
+	assert.Equal(t, []string{"false"}, form.Value["send_completion_email"])
+	assert.NotContains(t, form.Value, "external_id")
+
+	file, err := form.File["users"][0].Open()
+	require.NoError(t, err)
+
+	data, err := io.ReadAll(file)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[{"email": "one@example.com"}, {"email": "two@example.com", "password_hash": "$2b$10$hash"}]`, string(data))
+}
+
+func TestJobsImportUsersTooLarge(t *testing.T) {
+	svc, _ := newMockedManagement(t)
+
+	// An endless stream of users is only read until the file is too large, and
+	// nothing is uploaded: the mocked Doer fails on any request
+	read := 0
+	users := func(yield func(mgmt.ImportUser) bool) {
+		for {
+			read++
+			if !yield(mgmt.ImportUser{Email: fmt.Sprintf("user%d@example.com", read), Name: strings.Repeat("x", 1000)}) {
+				return
+			}
+		}
+	}
+
+	_, err := svc.Jobs.ImportUsers(mgmt.UsersImportOpts{ConnectionID: "con_1"}, users)
+	require.ErrorIs(t, err, mgmt.ErrUsersImportTooLarge)
+	assert.Less(t, read, 1000)
+}
+
+func TestJobsWait(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	expectRequest(t, mockDoer, gohttp.MethodGet, "/jobs/job_1", `{"id": "job_1", "status": "pending"}`)
+	expectRequest(t, mockDoer, gohttp.MethodGet, "/jobs/job_1", `{"id": "job_1", "status": "processing"}`)
+	expectRequest(t, mockDoer, gohttp.MethodGet, "/jobs/job_1",
+		`{"id": "job_1", "status": "completed", "location": "https://exports.example.com/job_1.csv.gz"}`)
+
+	job, err := svc.Jobs.Wait(context.Background(), "job_1", time.Millisecond)
+	require.NoError(t, err)
+	assert.True(t, job.Done())
+	assert.Equal(t, "https://exports.example.com/job_1.csv.gz", job.Location)
+
+	expectRequest(t, mockDoer, gohttp.MethodGet, "/jobs/job_2", `{"id": "job_2", "status": "failed"}`)
+
+	_, err = svc.Jobs.Wait(context.Background(), "job_2", time.Millisecond)
+	assert.ErrorIs(t, err, mgmt.ErrJobFailed)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	expectRequest(t, mockDoer, gohttp.MethodGet, "/jobs/job_3", `{"id": "job_3", "status": "pending"}`)
+
+	_, err = svc.Jobs.Wait(ctx, "job_3", time.Hour)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestJobsDownloadExport(t *testing.T) {
+	svc, _ := newMockedManagement(t)
+
+	server := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		gz := gzip.NewWriter(w)
+		_, _ = gz.Write([]byte("email\none@example.com\n"))
+		_ = gz.Close()
+	}))
+	defer server.Close()
+
+	var out bytes.Buffer
+
+	err := svc.Jobs.DownloadExport(context.Background(), mgmt.Job{ID: "job_1", Location: server.URL}, &out)
+	require.NoError(t, err)
+	assert.Equal(t, "email\none@example.com\n", out.String())
+
+	err = svc.Jobs.DownloadExport(context.Background(), mgmt.Job{ID: "job_1"}, &out)
+	assert.ErrorIs(t, err, mgmt.ErrNoExportLocation)
+}
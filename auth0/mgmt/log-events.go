@@ -0,0 +1,255 @@
+package mgmt
+
+import (
+	"sort"
+	"strings"
+)
+
+// EventType is the type code of a tenant log event, e.g. "s" or "fp"
+type EventType string
+
+// EventCategory groups related event types
+type EventCategory string
+
+// Event categories
+const (
+	EventCategoryLogin          EventCategory = "login"
+	EventCategoryLogout         EventCategory = "logout"
+	EventCategorySignup         EventCategory = "signup"
+	EventCategoryToken          EventCategory = "token"
+	EventCategoryMFA            EventCategory = "mfa"
+	EventCategoryPassword       EventCategory = "password"
+	EventCategoryEmail          EventCategory = "email"
+	EventCategoryUserManagement EventCategory = "user_management"
+	EventCategoryManagementAPI  EventCategory = "management_api"
+	EventCategorySecurity       EventCategory = "security"
+	EventCategoryRateLimit      EventCategory = "rate_limit"
+	EventCategorySystem         EventCategory = "system"
+	EventCategoryUnknown        EventCategory = "unknown"
+)
+
+// Event types
+const (
+	EventSuccessLogin                 EventType = "s"
+	EventFailedLogin                  EventType = "f"
+	EventFailedLoginIncorrectPassword EventType = "fp"
+	EventFailedLoginInvalidUser       EventType = "fu"
+	EventFailedByConnector            EventType = "fc"
+	EventFailedByCORS                 EventType = "fco"
+	EventSuccessCrossOriginAuth       EventType = "scoa"
+	EventFailedCrossOriginAuth        EventType = "fcoa"
+	EventSuccessSilentAuth            EventType = "ssa"
+	EventFailedSilentAuth             EventType = "fsa"
+	EventWarningDuringLogin           EventType = "w"
+	EventSuccessLogout                EventType = "slo"
+	EventFailedLogout                 EventType = "flo"
+
+	EventSuccessSignup EventType = "ss"
+	EventFailedSignup  EventType = "fs"
+
+	EventSuccessExchangeAuthCode          EventType = "seacft"
+	EventFailedExchangeAuthCode           EventType = "feacft"
+	EventSuccessExchangeClientCredentials EventType = "seccft"
+	EventFailedExchangeClientCredentials  EventType = "feccft"
+	EventSuccessExchangePassword          EventType = "sepft"
+	EventFailedExchangePassword           EventType = "fepft"
+	EventSuccessExchangeRefreshToken      EventType = "sertft"
+	EventFailedExchangeRefreshToken       EventType = "fertft"
+	EventSuccessExchangeDeviceCode        EventType = "sede"
+	EventFailedExchangeDeviceCode         EventType = "fede"
+	EventSuccessRevokeRefreshToken        EventType = "srrt"
+
+	EventMFARequired          EventType = "mfar"
+	EventMFAEnrollComplete    EventType = "gd_enrollment_complete"
+	EventMFAStartEnroll       EventType = "gd_start_enroll"
+	EventMFAUnenroll          EventType = "gd_unenroll"
+	EventMFAAuthSucceeded     EventType = "gd_auth_succeed"
+	EventMFAAuthFailed        EventType = "gd_auth_failed"
+	EventMFASendSMS           EventType = "gd_send_sms"
+	EventMFARecoverySucceeded EventType = "gd_recovery_succeed"
+	EventMFARecoveryFailed    EventType = "gd_recovery_failed"
+	EventMFAOTPRateLimit      EventType = "gd_otp_rate_limit_exceed"
+
+	EventSuccessChangePassword        EventType = "scp"
+	EventFailedChangePassword         EventType = "fcp"
+	EventSuccessChangePasswordRequest EventType = "scpr"
+	EventFailedChangePasswordRequest  EventType = "fcpr"
+
+	EventSuccessVerificationEmail        EventType = "sv"
+	EventFailedVerificationEmail         EventType = "fv"
+	EventSuccessVerificationEmailRequest EventType = "svr"
+	EventFailedVerificationEmailRequest  EventType = "fvr"
+	EventSuccessChangeEmail              EventType = "sce"
+	EventFailedChangeEmail               EventType = "fce"
+	EventCodeLinkSent                    EventType = "cls"
+	EventCodeSent                        EventType = "cs"
+	EventFailedSendingNotification       EventType = "fn"
+
+	EventSuccessChangeUsername EventType = "scu"
+	EventFailedChangeUsername  EventType = "fcu"
+	EventDeletedUser           EventType = "du"
+	EventFailedDeleteUser      EventType = "fdu"
+	EventSuccessUsersImport    EventType = "sui"
+	EventFailedUsersImport     EventType = "fui"
+	EventUserBlockReleased     EventType = "ublkdu"
+
+	EventSuccessAPIOperation EventType = "sapi"
+	EventFailedAPIOperation  EventType = "fapi"
+
+	EventBlockedAccount                EventType = "limit_wc"
+	EventBlockedIPAddress              EventType = "limit_mu"
+	EventBlockedAccountLoginsPerMinute EventType = "limit_sul"
+	EventBreachedPassword              EventType = "pwd_leak"
+	EventBreachedPasswordSignup        EventType = "signup_pwd_leak"
+	EventBreachedPasswordReset         EventType = "reset_pwd_leak"
+
+	EventAPIRateLimit EventType = "api_limit"
+
+	EventDeprecationNotice EventType = "depnote"
+)
+
+// EventInfo describes an event type
+type EventInfo struct {
+	Type        EventType
+	Description string
+	Category    EventCategory
+}
+
+var eventCatalog = map[EventType]EventInfo{}
+
+func init() {
+	for _, info := range []EventInfo{
+		{EventSuccessLogin, "Success Login", EventCategoryLogin},
+		{EventFailedLogin, "Failed Login", EventCategoryLogin},
+		{EventFailedLoginIncorrectPassword, "Failed Login (Incorrect Password)", EventCategoryLogin},
+		{EventFailedLoginInvalidUser, "Failed Login (Invalid Email/Username)", EventCategoryLogin},
+		{EventFailedByConnector, "Failed by Connector", EventCategoryLogin},
+		{EventFailedByCORS, "Failed by CORS", EventCategoryLogin},
+		{EventSuccessCrossOriginAuth, "Success Cross Origin Authentication", EventCategoryLogin},
+		{EventFailedCrossOriginAuth, "Failed Cross Origin Authentication", EventCategoryLogin},
+		{EventSuccessSilentAuth, "Success Silent Auth", EventCategoryLogin},
+		{EventFailedSilentAuth, "Failed Silent Auth", EventCategoryLogin},
+		{EventWarningDuringLogin, "Warnings During Login", EventCategoryLogin},
+		{EventSuccessLogout, "Success Logout", EventCategoryLogout},
+		{EventFailedLogout, "Failed Logout", EventCategoryLogout},
+
+		{EventSuccessSignup, "Success Signup", EventCategorySignup},
+		{EventFailedSignup, "Failed Signup", EventCategorySignup},
+
+		{EventSuccessExchangeAuthCode, "Success Exchange (Authorization Code for Access Token)", EventCategoryToken},
+		{EventFailedExchangeAuthCode, "Failed Exchange (Authorization Code for Access Token)", EventCategoryToken},
+		{EventSuccessExchangeClientCredentials, "Success Exchange (Client Credentials for Access Token)", EventCategoryToken},
+		{EventFailedExchangeClientCredentials, "Failed Exchange (Client Credentials for Access Token)", EventCategoryToken},
+		{EventSuccessExchangePassword, "Success Exchange (Password for Access Token)", EventCategoryToken},
+		{EventFailedExchangePassword, "Failed Exchange (Password for Access Token)", EventCategoryToken},
+		{EventSuccessExchangeRefreshToken, "Success Exchange (Refresh Token for Access Token)", EventCategoryToken},
+		{EventFailedExchangeRefreshToken, "Failed Exchange (Refresh Token for Access Token)", EventCategoryToken},
+		{EventSuccessExchangeDeviceCode, "Success Exchange (Device Code for Access Token)", EventCategoryToken},
+		{EventFailedExchangeDeviceCode, "Failed Exchange (Device Code for Access Token)", EventCategoryToken},
+		{EventSuccessRevokeRefreshToken, "Success Revocation (Refresh Token)", EventCategoryToken},
+
+		{EventMFARequired, "MFA Required", EventCategoryMFA},
+		{EventMFAEnrollComplete, "MFA Enrollment Complete", EventCategoryMFA},
+		{EventMFAStartEnroll, "MFA Enrollment Started", EventCategoryMFA},
+		{EventMFAUnenroll, "MFA Unenrolled", EventCategoryMFA},
+		{EventMFAAuthSucceeded, "MFA Authentication Succeeded", EventCategoryMFA},
+		{EventMFAAuthFailed, "MFA Authentication Failed", EventCategoryMFA},
+		{EventMFASendSMS, "MFA SMS Sent", EventCategoryMFA},
+		{EventMFARecoverySucceeded, "MFA Recovery Succeeded", EventCategoryMFA},
+		{EventMFARecoveryFailed, "MFA Recovery Failed", EventCategoryMFA},
+		{EventMFAOTPRateLimit, "MFA Too Many Failures", EventCategoryMFA},
+
+		{EventSuccessChangePassword, "Success Change Password", EventCategoryPassword},
+		{EventFailedChangePassword, "Failed Change Password", EventCategoryPassword},
+		{EventSuccessChangePasswordRequest, "Success Change Password Request", EventCategoryPassword},
+		{EventFailedChangePasswordRequest, "Failed Change Password Request", EventCategoryPassword},
+
+		{EventSuccessVerificationEmail, "Success Verification Email", EventCategoryEmail},
+		{EventFailedVerificationEmail, "Failed Verification Email", EventCategoryEmail},
+		{EventSuccessVerificationEmailRequest, "Success Verification Email Request", EventCategoryEmail},
+		{EventFailedVerificationEmailRequest, "Failed Verification Email Request", EventCategoryEmail},
+		{EventSuccessChangeEmail, "Success Change Email", EventCategoryEmail},
+		{EventFailedChangeEmail, "Failed Change Email", EventCategoryEmail},
+		{EventCodeLinkSent, "Code/Link Sent", EventCategoryEmail},
+		{EventCodeSent, "Code Sent", EventCategoryEmail},
+		{EventFailedSendingNotification, "Failed Sending Notification", EventCategoryEmail},
+
+		{EventSuccessChangeUsername, "Success Change Username", EventCategoryUserManagement},
+		{EventFailedChangeUsername, "Failed Change Username", EventCategoryUserManagement},
+		{EventDeletedUser, "Deleted User", EventCategoryUserManagement},
+		{EventFailedDeleteUser, "Failed User Deletion", EventCategoryUserManagement},
+		{EventSuccessUsersImport, "Success Users Import", EventCategoryUserManagement},
+		{EventFailedUsersImport, "Failed Users Import", EventCategoryUserManagement},
+		{EventUserBlockReleased, "User Login Block Released", EventCategoryUserManagement},
+
+		{EventSuccessAPIOperation, "Success API Operation", EventCategoryManagementAPI},
+		{EventFailedAPIOperation, "Failed API Operation", EventCategoryManagementAPI},
+
+		{EventBlockedAccount, "Blocked Account (Too Many Failed Logins)", EventCategorySecurity},
+		{EventBlockedIPAddress, "Blocked IP Address (Too Many Accounts)", EventCategorySecurity},
+		{EventBlockedAccountLoginsPerMinute, "Blocked Account (Too Many Logins per Minute)", EventCategorySecurity},
+		{EventBreachedPassword, "Breached Password", EventCategorySecurity},
+		{EventBreachedPasswordSignup, "Breached Password on Signup", EventCategorySecurity},
+		{EventBreachedPasswordReset, "Breached Password on Reset", EventCategorySecurity},
+
+		{EventAPIRateLimit, "Rate Limit on API", EventCategoryRateLimit},
+
+		{EventDeprecationNotice, "Deprecation Notice", EventCategorySystem},
+	} {
+		eventCatalog[info.Type] = info
+	}
+}
+
+// LookupEvent returns what is known about an event type, and whether it is
+// part of the catalog
+func LookupEvent(t EventType) (EventInfo, bool) {
+	info, ok := eventCatalog[t]
+
+	return info, ok
+}
+
+// Info returns what is known about the event type. Types missing from the
+// catalog are returned with EventCategoryUnknown.
+func (t EventType) Info() EventInfo {
+	if info, ok := eventCatalog[t]; ok {
+		return info
+	}
+
+	return EventInfo{Type: t, Category: EventCategoryUnknown}
+}
+
+// Description returns the description of the event type
+func (t EventType) Description() string {
+	return t.Info().Description
+}
+
+// Category returns the category of the event type
+func (t EventType) Category() EventCategory {
+	return t.Info().Category
+}
+
+// Types returns the event types of the category, sorted by code
+func (c EventCategory) Types() []EventType {
+	var types []EventType
+
+	for t, info := range eventCatalog {
+		if info.Category == c {
+			types = append(types, t)
+		}
+	}
+
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+
+	return types
+}
+
+// EventTypeQuery returns a Lucene query matching log events of any of the
+// given types, for use as SearchLogsOpts.Q
+func EventTypeQuery(types ...EventType) string {
+	quoted := make([]string, len(types))
+	for i, t := range types {
+		quoted[i] = `"` + string(t) + `"`
+	}
+
+	return "type:(" + strings.Join(quoted, " OR ") + ")"
+}
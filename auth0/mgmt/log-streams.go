@@ -0,0 +1,273 @@
+package mgmt
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/zenoss/go-auth0/auth0/http"
+)
+
+// ErrUnknownLogStreamType is returned when decoding the sink of a log stream
+// type this package has no sink type for
+var ErrUnknownLogStreamType = errors.New("go-auth0: unknown log stream type")
+
+// LogStreamsService provides a service for log stream related functions
+type LogStreamsService struct {
+	c *http.Client
+}
+
+// Log stream types
+const (
+	LogStreamTypeHTTP        = "http"
+	LogStreamTypeDatadog     = "datadog"
+	LogStreamTypeSplunk      = "splunk"
+	LogStreamTypeSumo        = "sumo"
+	LogStreamTypeEventBridge = "eventbridge"
+)
+
+// Log stream statuses. Auth0 suspends a stream after repeated delivery
+// failures; set it back to active to resume it.
+const (
+	LogStreamStatusActive    = "active"
+	LogStreamStatusPaused    = "paused"
+	LogStreamStatusSuspended = "suspended"
+)
+
+// Log stream filter categories
+const (
+	LogStreamCategoryLoginSuccess         = "auth.login.success"
+	LogStreamCategoryLoginFail            = "auth.login.fail"
+	LogStreamCategoryLoginNotification    = "auth.login.notification"
+	LogStreamCategoryLogoutSuccess        = "auth.logout.success"
+	LogStreamCategoryLogoutFail           = "auth.logout.fail"
+	LogStreamCategorySilentAuthSuccess    = "auth.silent_auth.success"
+	LogStreamCategorySilentAuthFail       = "auth.silent_auth.fail"
+	LogStreamCategoryTokenExchangeSuccess = "auth.token_exchange.success"
+	LogStreamCategoryTokenExchangeFail    = "auth.token_exchange.fail"
+	LogStreamCategoryManagementSuccess    = "management.success"
+	LogStreamCategoryManagementFail       = "management.fail"
+	LogStreamCategorySystemNotification   = "system.notification"
+	LogStreamCategoryUserSuccess          = "user.success"
+	LogStreamCategoryUserFail             = "user.fail"
+	LogStreamCategoryUserNotification     = "user.notification"
+	LogStreamCategoryActions              = "actions"
+	LogStreamCategoryOther                = "other"
+)
+
+// LogStreamFilter restricts which events are sent to a log stream
+type LogStreamFilter struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+// CategoryFilters returns filters sending only events of the given categories
+func CategoryFilters(categories ...string) []LogStreamFilter {
+	filters := make([]LogStreamFilter, len(categories))
+	for i, category := range categories {
+		filters[i] = LogStreamFilter{Type: "category", Name: category}
+	}
+
+	return filters
+}
+
+// LogStreamSink is the type specific configuration of where a log stream
+// sends events
+type LogStreamSink interface {
+	LogStreamType() string
+}
+
+// HTTPHeader is a custom header sent with HTTP log stream requests
+type HTTPHeader struct {
+	Header string `json:"header"`
+	Value  string `json:"value"`
+}
+
+// HTTPSink sends events to a webhook
+type HTTPSink struct {
+	Endpoint      string       `json:"httpEndpoint,omitempty"`
+	Authorization string       `json:"httpAuthorization,omitempty"`
+	ContentType   string       `json:"httpContentType,omitempty"`
+	ContentFormat string       `json:"httpContentFormat,omitempty"`
+	CustomHeaders []HTTPHeader `json:"httpCustomHeaders,omitempty"`
+}
+
+// LogStreamType returns LogStreamTypeHTTP
+func (HTTPSink) LogStreamType() string { return LogStreamTypeHTTP }
+
+// DatadogSink sends events to Datadog
+type DatadogSink struct {
+	APIKey string `json:"datadogApiKey,omitempty"`
+	Region string `json:"datadogRegion,omitempty"`
+}
+
+// LogStreamType returns LogStreamTypeDatadog
+func (DatadogSink) LogStreamType() string { return LogStreamTypeDatadog }
+
+// SplunkSink sends events to a Splunk HTTP event collector
+type SplunkSink struct {
+	Domain string `json:"splunkDomain,omitempty"`
+	Token  string `json:"splunkToken,omitempty"`
+	Port   string `json:"splunkPort,omitempty"`
+	Secure *bool  `json:"splunkSecure,omitempty"`
+}
+
+// LogStreamType returns LogStreamTypeSplunk
+func (SplunkSink) LogStreamType() string { return LogStreamTypeSplunk }
+
+// SumoSink sends events to a Sumo Logic HTTP source
+type SumoSink struct {
+	SourceAddress string `json:"sumoSourceAddress,omitempty"`
+}
+
+// LogStreamType returns LogStreamTypeSumo
+func (SumoSink) LogStreamType() string { return LogStreamTypeSumo }
+
+// EventBridgeSink sends events to Amazon EventBridge. PartnerEventSource is
+// set by Auth0 once the stream is created, and can't be changed.
+type EventBridgeSink struct {
+	AccountID          string `json:"awsAccountId,omitempty"`
+	Region             string `json:"awsRegion,omitempty"`
+	PartnerEventSource string `json:"awsPartnerEventSource,omitempty"`
+}
+
+// LogStreamType returns LogStreamTypeEventBridge
+func (EventBridgeSink) LogStreamType() string { return LogStreamTypeEventBridge }
+
+// LogStream is a log stream in Auth0
+type LogStream struct {
+	ID         string            `json:"id,omitempty"`
+	Name       string            `json:"name,omitempty"`
+	Type       string            `json:"type,omitempty"`
+	Status     string            `json:"status,omitempty"`
+	Filters    []LogStreamFilter `json:"filters,omitempty"`
+	IsPriority bool              `json:"isPriority,omitempty"`
+	Sink       json.RawMessage   `json:"sink,omitempty"`
+}
+
+// DecodeSink returns the sink of the log stream as the sink type matching
+// its Type, e.g. *HTTPSink for LogStreamTypeHTTP
+func (ls LogStream) DecodeSink() (LogStreamSink, error) {
+	var sink LogStreamSink
+
+	switch ls.Type {
+	case LogStreamTypeHTTP:
+		sink = &HTTPSink{}
+	case LogStreamTypeDatadog:
+		sink = &DatadogSink{}
+	case LogStreamTypeSplunk:
+		sink = &SplunkSink{}
+	case LogStreamTypeSumo:
+		sink = &SumoSink{}
+	case LogStreamTypeEventBridge:
+		sink = &EventBridgeSink{}
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownLogStreamType, ls.Type)
+	}
+
+	if len(ls.Sink) == 0 {
+		return sink, nil
+	}
+
+	if err := json.Unmarshal(ls.Sink, sink); err != nil {
+		return nil, err
+	}
+
+	return sink, nil
+}
+
+// LogStreamOpts are options which can be used to create a LogStream. The
+// type of the stream is the type of Sink. StartFrom is the ID of a log event
+// to start streaming from.
+type LogStreamOpts struct {
+	Name       string
+	Sink       LogStreamSink
+	Filters    []LogStreamFilter
+	IsPriority bool
+	StartFrom  string
+}
+
+// MarshalJSON adds the type of Sink to the log stream
+func (opts LogStreamOpts) MarshalJSON() ([]byte, error) {
+	body := struct {
+		Name       string            `json:"name,omitempty"`
+		Type       string            `json:"type"`
+		Sink       LogStreamSink     `json:"sink"`
+		Filters    []LogStreamFilter `json:"filters,omitempty"`
+		IsPriority bool              `json:"isPriority,omitempty"`
+		StartFrom  string            `json:"startFrom,omitempty"`
+	}{
+		Name:       opts.Name,
+		Sink:       opts.Sink,
+		Filters:    opts.Filters,
+		IsPriority: opts.IsPriority,
+		StartFrom:  opts.StartFrom,
+	}
+
+	if opts.Sink != nil {
+		body.Type = opts.Sink.LogStreamType()
+	}
+
+	return json.Marshal(body)
+}
+
+// LogStreamUpdateOpts are options which can be used to update a LogStream.
+// Sink must be of the same type as the stream; only its set fields change.
+type LogStreamUpdateOpts struct {
+	Name       string             `json:"name,omitempty"`
+	Status     string             `json:"status,omitempty"`
+	Sink       LogStreamSink      `json:"sink,omitempty"`
+	Filters    *[]LogStreamFilter `json:"filters,omitempty"`
+	IsPriority *bool              `json:"isPriority,omitempty"`
+}
+
+// GetAll returns all log streams
+func (svc *LogStreamsService) GetAll() ([]LogStream, error) {
+	var logStreams []LogStream
+
+	err := svc.c.Get("/log-streams", &logStreams)
+
+	return logStreams, err
+}
+
+// Get returns a log stream
+func (svc *LogStreamsService) Get(logStreamID string) (LogStream, error) {
+	var logStream LogStream
+
+	err := svc.c.Get("/log-streams/"+logStreamID, &logStream)
+
+	return logStream, err
+}
+
+// Create creates a log stream
+func (svc *LogStreamsService) Create(opts LogStreamOpts) (LogStream, error) {
+	var logStream LogStream
+
+	err := svc.c.Post("/log-streams", opts, &logStream)
+
+	return logStream, err
+}
+
+// Update updates a log stream
+func (svc *LogStreamsService) Update(logStreamID string, opts LogStreamUpdateOpts) (LogStream, error) {
+	var logStream LogStream
+
+	err := svc.c.Patch("/log-streams/"+logStreamID, &opts, &logStream)
+
+	return logStream, err
+}
+
+// Pause stops sending events to a log stream
+func (svc *LogStreamsService) Pause(logStreamID string) (LogStream, error) {
+	return svc.Update(logStreamID, LogStreamUpdateOpts{Status: LogStreamStatusPaused})
+}
+
+// Resume resumes sending events to a paused or suspended log stream
+func (svc *LogStreamsService) Resume(logStreamID string) (LogStream, error) {
+	return svc.Update(logStreamID, LogStreamUpdateOpts{Status: LogStreamStatusActive})
+}
+
+// Delete deletes a log stream
+func (svc *LogStreamsService) Delete(logStreamID string) error {
+	return svc.c.Delete("/log-streams/"+logStreamID, nil, nil)
+}
@@ -0,0 +1,68 @@
+package mgmt_test
+
+import (
+	gohttp "net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zenoss/go-auth0/auth0/mgmt"
+)
+
+func TestLogStreamsCreate(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	req := expectRequest(t, mockDoer, gohttp.MethodPost, "/log-streams", `{
+		"id": "lst_1", "name": "siem", "type": "splunk", "status": "active",
+		"sink": {"splunkDomain": "splunk.example.com", "splunkPort": "8088", "splunkSecure": true}
+	}`)
+
+	stream, err := svc.LogStreams.Create(mgmt.LogStreamOpts{
+		Name: "siem",
+		Sink: mgmt.SplunkSink{
+			Domain: "splunk.example.com",
+			Token:  "secret",
+			Port:   "8088",
+			Secure: mgmt.Bool(true),
+		},
+		Filters: mgmt.CategoryFilters(mgmt.LogStreamCategoryLoginFail, mgmt.LogStreamCategoryManagementFail),
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"name": "siem",
+		"type": "splunk",
+		"sink": {"splunkDomain": "splunk.example.com", "splunkToken": "secret", "splunkPort": "8088", "splunkSecure": true},
+		"filters": [{"type": "category", "name": "auth.login.fail"}, {"type": "category", "name": "management.fail"}]
+	}`, string(req.Body))
+
+	sink, err := stream.DecodeSink()
+	require.NoError(t, err)
+	assert.Equal(t, "splunk.example.com", sink.(*mgmt.SplunkSink).Domain)
+}
+
+func TestLogStreamsGetAllAndResume(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	expectRequest(t, mockDoer, gohttp.MethodGet, "/log-streams", `[
+		{"id": "lst_1", "type": "datadog", "status": "suspended", "sink": {"datadogRegion": "eu"}},
+		{"id": "lst_2", "type": "segment", "status": "active"}
+	]`)
+
+	streams, err := svc.LogStreams.GetAll()
+	require.NoError(t, err)
+	require.Len(t, streams, 2)
+
+	sink, err := streams[0].DecodeSink()
+	require.NoError(t, err)
+	assert.Equal(t, &mgmt.DatadogSink{Region: "eu"}, sink)
+
+	_, err = streams[1].DecodeSink()
+	assert.ErrorIs(t, err, mgmt.ErrUnknownLogStreamType)
+
+	req := expectRequest(t, mockDoer, gohttp.MethodPatch, "/log-streams/lst_1", `{"id": "lst_1", "status": "active"}`)
+
+	stream, err := svc.LogStreams.Resume("lst_1")
+	require.NoError(t, err)
+	assert.Equal(t, mgmt.LogStreamStatusActive, stream.Status)
+	assert.JSONEq(t, `{"status": "active"}`, string(req.Body))
+}
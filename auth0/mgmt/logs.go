@@ -0,0 +1,221 @@
+package mgmt
+
+import (
+	"github.com/google/go-querystring/query"
+
+	"github.com/zenoss/go-auth0/auth0/http"
+)
+
+// maxLogsTake is the most log events Auth0 returns for one checkpoint page
+const maxLogsTake = 100
+
+// LogsService provides a service for tenant log related functions
+type LogsService struct {
+	c *http.Client
+}
+
+// LogLocation is where a log event came from, as resolved from its IP
+type LogLocation struct {
+	CountryCode    string  `json:"country_code,omitempty"`
+	CountryCode3   string  `json:"country_code3,omitempty"`
+	CountryName    string  `json:"country_name,omitempty"`
+	CityName       string  `json:"city_name,omitempty"`
+	Latitude       float64 `json:"latitude,omitempty"`
+	Longitude      float64 `json:"longitude,omitempty"`
+	TimeZone       string  `json:"time_zone,omitempty"`
+	ContinentCode  string  `json:"continent_code,omitempty"`
+	SubdivisionISO string  `json:"subdivision_code,omitempty"`
+}
+
+// Log is an event of the tenant log in Auth0
+type Log struct {
+	ID           string         `json:"log_id,omitempty"`
+	Date         string         `json:"date,omitempty"`
+	Type         EventType      `json:"type,omitempty"`
+	Description  string         `json:"description,omitempty"`
+	Connection   string         `json:"connection,omitempty"`
+	ConnectionID string         `json:"connection_id,omitempty"`
+	ClientID     string         `json:"client_id,omitempty"`
+	ClientName   string         `json:"client_name,omitempty"`
+	IP           string         `json:"ip,omitempty"`
+	Hostname     string         `json:"hostname,omitempty"`
+	UserID       string         `json:"user_id,omitempty"`
+	UserName     string         `json:"user_name,omitempty"`
+	Audience     string         `json:"audience,omitempty"`
+	Scope        string         `json:"scope,omitempty"`
+	Strategy     string         `json:"strategy,omitempty"`
+	StrategyType string         `json:"strategy_type,omitempty"`
+	UserAgent    string         `json:"user_agent,omitempty"`
+	IsMobile     bool           `json:"isMobile,omitempty"`
+	Location     *LogLocation   `json:"location_info,omitempty"`
+	Details      map[string]any `json:"details,omitempty"`
+}
+
+// Event returns what is known about the type of the log event
+func (l Log) Event() EventInfo {
+	return l.Type.Info()
+}
+
+type LogsPage struct {
+	Start  int   `json:"start,omitempty"`
+	Limit  int   `json:"limit,omitempty"`
+	Length int   `json:"length,omitempty"`
+	Total  int   `json:"total,omitempty"`
+	Logs   []Log `json:"logs,omitempty"`
+}
+
+// SearchLogsOpts defines what can be used to search the tenant logs. Q is a
+// Lucene query, e.g. `type:"fp" AND user_id:"auth0|123"`; see EventTypeQuery.
+type SearchLogsOpts struct {
+	PerPage       int    `url:"per_page,omitempty"`
+	Page          int    `url:"page,omitempty"`
+	IncludeTotals bool   `url:"include_totals,omitempty"`
+	Sort          string `url:"sort,omitempty"`
+	Fields        string `url:"fields,omitempty"`
+	IncludeFields *bool  `url:"include_fields,omitempty"`
+	Q             string `url:"q,omitempty"`
+}
+
+// Encode creates a url.Values encoding of SearchLogsOpts.
+func (opts *SearchLogsOpts) Encode() (string, error) {
+	vals, err := query.Values(opts)
+	if err != nil {
+		return "", err
+	}
+
+	return vals.Encode(), nil
+}
+
+// SearchUserLogsOpts defines what can be used to search the logs of a user
+type SearchUserLogsOpts struct {
+	PerPage       int    `url:"per_page,omitempty"`
+	Page          int    `url:"page,omitempty"`
+	IncludeTotals bool   `url:"include_totals,omitempty"`
+	Sort          string `url:"sort,omitempty"`
+}
+
+// Encode creates a url.Values encoding of SearchUserLogsOpts.
+func (opts *SearchUserLogsOpts) Encode() (string, error) {
+	vals, err := query.Values(opts)
+	if err != nil {
+		return "", err
+	}
+
+	return vals.Encode(), nil
+}
+
+// Get returns a log event
+func (svc *LogsService) Get(logID string) (Log, error) {
+	var log Log
+
+	err := svc.c.Get("/logs/"+logID, &log)
+
+	return log, err
+}
+
+// Search retrieves log events according to search criteria
+func (svc *LogsService) Search(opts SearchLogsOpts) (*LogsPage, error) {
+	var logsPage LogsPage
+
+	queryString, err := opts.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	url := "/logs"
+	if queryString != "" {
+		url = "/logs?" + queryString
+	}
+
+	if opts.IncludeTotals {
+		err = svc.c.Get(url, &logsPage)
+	} else {
+		err = svc.c.Get(url, &logsPage.Logs)
+	}
+
+	return &logsPage, err
+}
+
+// SearchByUser retrieves the log events of a user
+func (svc *LogsService) SearchByUser(userID string, opts SearchUserLogsOpts) (*LogsPage, error) {
+	var logsPage LogsPage
+
+	queryString, err := opts.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	url := "/users/" + userID + "/logs"
+	if queryString != "" {
+		url += "?" + queryString
+	}
+
+	if opts.IncludeTotals {
+		err = svc.c.Get(url, &logsPage)
+	} else {
+		err = svc.c.Get(url, &logsPage.Logs)
+	}
+
+	return &logsPage, err
+}
+
+// List returns up to take log events which happened after the event with ID
+// from, oldest first. Pass the ID of the last event returned to read the
+// following events. Without from, Auth0 returns the most recent events.
+func (svc *LogsService) List(from string, take int) ([]Log, error) {
+	var logs []Log
+
+	if take <= 0 || take > maxLogsTake {
+		take = maxLogsTake
+	}
+
+	opts := CheckpointOpts{From: from, Take: take}
+
+	queryString, err := opts.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	err = svc.c.Get("/logs?"+queryString, &logs)
+
+	return logs, err
+}
+
+// GetAllFrom returns all log events which happened after the event with ID
+// from, oldest first. Keep the ID of the last event as a checkpoint to
+// resume from later. If from is empty, all retained log events are returned,
+// starting with the oldest one.
+func (svc *LogsService) GetAllFrom(from string) ([]Log, error) {
+	var logs []Log
+
+	// Without from, Auth0 returns the latest events newest first, so start
+	// from the oldest event instead
+	if from == "" {
+		oldest, err := svc.Search(SearchLogsOpts{Sort: "date:1", PerPage: 1})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(oldest.Logs) == 0 {
+			return nil, nil
+		}
+
+		logs = append(logs, oldest.Logs[0])
+		from = oldest.Logs[0].ID
+	}
+
+	for {
+		page, err := svc.List(from, maxLogsTake)
+		if err != nil {
+			return logs, err
+		}
+
+		logs = append(logs, page...)
+
+		if len(page) < maxLogsTake {
+			return logs, nil
+		}
+
+		from = page[len(page)-1].ID
+	}
+}
@@ -0,0 +1,89 @@
+package mgmt_test
+
+import (
+	gohttp "net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zenoss/go-auth0/auth0/mgmt"
+)
+
+func TestLogsGetAllFrom(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	ids := make([]string, 100)
+	for i := range ids {
+		ids[i] = `{"log_id": "` + strconv.Itoa(1000+i) + `", "type": "s"}`
+	}
+
+	first := expectRequest(t, mockDoer, gohttp.MethodGet, "/logs", "["+strings.Join(ids, ",")+"]")
+	second := expectRequest(t, mockDoer, gohttp.MethodGet, "/logs", `[{"log_id": "1100", "type": "fp"}]`)
+
+	logs, err := svc.Logs.GetAllFrom("999")
+	require.NoError(t, err)
+	require.Len(t, logs, 101)
+
+	assert.Equal(t, "999", first.Query.Get("from"))
+	assert.Equal(t, "100", first.Query.Get("take"))
+	assert.Equal(t, "1099", second.Query.Get("from"))
+
+	last := logs[100]
+	assert.Equal(t, mgmt.EventFailedLoginIncorrectPassword, last.Type)
+	assert.Equal(t, mgmt.EventCategoryLogin, last.Event().Category)
+}
+
+func TestLogsGetAllFromOldest(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	oldest := expectRequest(t, mockDoer, gohttp.MethodGet, "/logs", `[{"log_id": "1", "type": "s"}]`)
+	next := expectRequest(t, mockDoer, gohttp.MethodGet, "/logs", `[{"log_id": "2", "type": "s"}, {"log_id": "3", "type": "f"}]`)
+
+	logs, err := svc.Logs.GetAllFrom("")
+	require.NoError(t, err)
+	require.Len(t, logs, 3)
+	assert.Equal(t, []string{"1", "2", "3"}, []string{logs[0].ID, logs[1].ID, logs[2].ID})
+
+	assert.Equal(t, "date:1", oldest.Query.Get("sort"))
+	assert.Equal(t, "1", oldest.Query.Get("per_page"))
+	assert.Equal(t, "1", next.Query.Get("from"))
+
+	// No log events at all
+	expectRequest(t, mockDoer, gohttp.MethodGet, "/logs", `[]`)
+
+	logs, err = svc.Logs.GetAllFrom("")
+	require.NoError(t, err)
+	assert.Empty(t, logs)
+}
+
+func TestLogsSearch(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	req := expectRequest(t, mockDoer, gohttp.MethodGet, "/logs",
+		`{"total": 1, "logs": [{"log_id": "1", "type": "limit_wc", "ip": "10.0.0.1"}]}`)
+
+	page, err := svc.Logs.Search(mgmt.SearchLogsOpts{
+		Q:             mgmt.EventTypeQuery(mgmt.EventBlockedAccount, mgmt.EventBlockedIPAddress),
+		IncludeTotals: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, page.Total)
+	assert.Equal(t, mgmt.EventCategorySecurity, page.Logs[0].Type.Category())
+	assert.Equal(t, `type:("limit_wc" OR "limit_mu")`, req.Query.Get("q"))
+}
+
+func TestEventCatalog(t *testing.T) {
+	info, ok := mgmt.LookupEvent("seccft")
+	require.True(t, ok)
+	assert.Equal(t, mgmt.EventCategoryToken, info.Category)
+	assert.Equal(t, "Success Exchange (Client Credentials for Access Token)", info.Description)
+
+	_, ok = mgmt.LookupEvent("not_a_code")
+	assert.False(t, ok)
+	assert.Equal(t, mgmt.EventCategoryUnknown, mgmt.EventType("not_a_code").Category())
+
+	assert.Equal(t, []mgmt.EventType{mgmt.EventFailedSignup, mgmt.EventSuccessSignup},
+		mgmt.EventCategorySignup.Types())
+}
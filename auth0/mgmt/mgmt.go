@@ -11,6 +11,22 @@ type ManagementService struct {
 	Users             *UsersService
 	Connections       *ConnectionsService
 	DeviceCredentials *DeviceCredentials
+	Clients           *ClientsService
+	ResourceServers   *ResourceServersService
+	ClientGrants      *ClientGrantsService
+	Roles             *RolesService
+	Organizations     *OrganizationsService
+	Logs              *LogsService
+	LogStreams        *LogStreamsService
+	Jobs              *JobsService
+	Tickets           *TicketsService
+	Actions           *ActionsService
+	Guardian          *GuardianService
+	UserBlocks        *UserBlocksService
+	Sessions          *SessionsService
+	RefreshTokens     *RefreshTokensService
+	EmailProvider     *EmailProviderService
+	EmailTemplates    *EmailTemplatesService
 }
 
 // New creates a new ManagementService, backed by client
@@ -27,6 +43,54 @@ func New(client *http.Client) *ManagementService {
 	mgmt.DeviceCredentials = &DeviceCredentials{
 		c: mgmt.Client,
 	}
+	mgmt.Clients = &ClientsService{
+		c: mgmt.Client,
+	}
+	mgmt.ResourceServers = &ResourceServersService{
+		c: mgmt.Client,
+	}
+	mgmt.ClientGrants = &ClientGrantsService{
+		c: mgmt.Client,
+	}
+	mgmt.Roles = &RolesService{
+		c: mgmt.Client,
+	}
+	mgmt.Organizations = &OrganizationsService{
+		c: mgmt.Client,
+	}
+	mgmt.Logs = &LogsService{
+		c: mgmt.Client,
+	}
+	mgmt.LogStreams = &LogStreamsService{
+		c: mgmt.Client,
+	}
+	mgmt.Jobs = &JobsService{
+		c: mgmt.Client,
+	}
+	mgmt.Tickets = &TicketsService{
+		c: mgmt.Client,
+	}
+	mgmt.Actions = &ActionsService{
+		c: mgmt.Client,
+	}
+	mgmt.Guardian = &GuardianService{
+		c: mgmt.Client,
+	}
+	mgmt.UserBlocks = &UserBlocksService{
+		c: mgmt.Client,
+	}
+	mgmt.Sessions = &SessionsService{
+		c: mgmt.Client,
+	}
+	mgmt.RefreshTokens = &RefreshTokensService{
+		c: mgmt.Client,
+	}
+	mgmt.EmailProvider = &EmailProviderService{
+		c: mgmt.Client,
+	}
+	mgmt.EmailTemplates = &EmailTemplatesService{
+		c: mgmt.Client,
+	}
 
 	return mgmt
 }
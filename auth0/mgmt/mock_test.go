@@ -0,0 +1,72 @@
+package mgmt_test
+
+import (
+	"encoding/json"
+	"io"
+	gohttp "net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/zenoss/go-auth0/auth0/http"
+	mocks "github.com/zenoss/go-auth0/auth0/http/mocks"
+	"github.com/zenoss/go-auth0/auth0/mgmt"
+)
+
+// testAPIURL ends in v2 so GetV2 pages through results like it does against Auth0
+const testAPIURL = "https://example.auth0.com/api/v2"
+
+// capturedRequest records what a service sent to the mocked Doer
+type capturedRequest struct {
+	Header gohttp.Header
+	Query  url.Values
+	Body   []byte
+}
+
+// decodeBody unmarshals the captured request body into v
+func (r *capturedRequest) decodeBody(t *testing.T, v any) {
+	t.Helper()
+	assert.NoError(t, json.Unmarshal(r.Body, v))
+}
+
+func newMockedManagement(t *testing.T) (*mgmt.ManagementService, *mocks.Doer) {
+	mockDoer := mocks.NewDoer(t)
+	svc := mgmt.New(&http.Client{
+		Doer: mockDoer,
+		API:  testAPIURL,
+	})
+
+	return svc, mockDoer
+}
+
+// expectRequest expects a single request for method and path, answers it with
+// response (if not empty), and returns what was sent
+func expectRequest(t *testing.T, mockDoer *mocks.Doer, method, path, response string) *capturedRequest {
+	captured := &capturedRequest{}
+
+	mockDoer.On("Do", mock.MatchedBy(func(req *gohttp.Request) bool {
+		return req.Method == method && strings.TrimPrefix(req.URL.Path, "/api/v2") == path
+	}), mock.Anything).
+		Return(nil).
+		Run(func(args mock.Arguments) {
+			req := args.Get(0).(*gohttp.Request)
+			captured.Header = req.Header
+			captured.Query = req.URL.Query()
+
+			if req.Body != nil {
+				body, err := io.ReadAll(req.Body)
+				assert.NoError(t, err)
+
+				captured.Body = body
+			}
+
+			if response != "" {
+				err := json.Unmarshal([]byte(response), args.Get(1))
+				assert.NoErrorf(t, err, "failed to unmarshal JSON %q", err)
+			}
+		}).Once()
+
+	return captured
+}
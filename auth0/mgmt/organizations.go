@@ -0,0 +1,415 @@
+package mgmt
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/google/go-querystring/query"
+
+	"github.com/zenoss/go-auth0/auth0/http"
+)
+
+// OrganizationsService provides a service for organization related functions
+type OrganizationsService struct {
+	c *http.Client
+}
+
+// OrganizationBrandingColors are the colors used on an organization's login pages
+type OrganizationBrandingColors struct {
+	Primary        string `json:"primary,omitempty"`
+	PageBackground string `json:"page_background,omitempty"`
+}
+
+// OrganizationBranding is the branding of an organization's login pages
+type OrganizationBranding struct {
+	LogoURL string                      `json:"logo_url,omitempty"`
+	Colors  *OrganizationBrandingColors `json:"colors,omitempty"`
+}
+
+// Organization is an organization in Auth0
+type Organization struct {
+	ID          string                `json:"id,omitempty"`
+	Name        string                `json:"name,omitempty"`
+	DisplayName string                `json:"display_name,omitempty"`
+	Branding    *OrganizationBranding `json:"branding,omitempty"`
+	Metadata    map[string]string     `json:"metadata,omitempty"`
+}
+
+// OrganizationOpts are options which can be used to create an Organization
+type OrganizationOpts struct {
+	Name               string                  `json:"name"`
+	DisplayName        string                  `json:"display_name,omitempty"`
+	Branding           *OrganizationBranding   `json:"branding,omitempty"`
+	Metadata           map[string]string       `json:"metadata,omitempty"`
+	EnabledConnections []EnabledConnectionOpts `json:"enabled_connections,omitempty"`
+}
+
+// OrganizationUpdateOpts are options which can be used to update an Organization
+type OrganizationUpdateOpts struct {
+	Name        string                `json:"name,omitempty"`
+	DisplayName string                `json:"display_name,omitempty"`
+	Branding    *OrganizationBranding `json:"branding,omitempty"`
+	Metadata    map[string]string     `json:"metadata,omitempty"`
+}
+
+type OrganizationsPage struct {
+	Start         int            `json:"start,omitempty"`
+	Limit         int            `json:"limit,omitempty"`
+	Length        int            `json:"length,omitempty"`
+	Total         int            `json:"total,omitempty"`
+	Organizations []Organization `json:"organizations,omitempty"`
+}
+
+// SearchOrganizationsOpts defines what can be used to search organizations
+type SearchOrganizationsOpts struct {
+	PerPage       int  `url:"per_page,omitempty"`
+	Page          int  `url:"page,omitempty"`
+	IncludeTotals bool `url:"include_totals,omitempty"`
+}
+
+// Encode creates a url.Values encoding of SearchOrganizationsOpts.
+func (opts *SearchOrganizationsOpts) Encode() (string, error) {
+	vals, err := query.Values(opts)
+	if err != nil {
+		return "", err
+	}
+
+	return vals.Encode(), nil
+}
+
+// OrganizationMember is a member of an organization
+type OrganizationMember struct {
+	ID      string `json:"user_id,omitempty"`
+	Email   string `json:"email,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Picture string `json:"picture,omitempty"`
+	Roles   []Role `json:"roles,omitempty"`
+}
+
+// OrganizationMembersPage is a page of members read with checkpoint
+// pagination. Pass Next as From to read the following page.
+type OrganizationMembersPage struct {
+	Members []OrganizationMember `json:"members,omitempty"`
+	Next    string               `json:"next,omitempty"`
+}
+
+// CheckpointOpts defines a page of an endpoint using checkpoint pagination
+type CheckpointOpts struct {
+	From   string `url:"from,omitempty"`
+	Take   int    `url:"take,omitempty"`
+	Fields string `url:"fields,omitempty"`
+}
+
+// Encode creates a url.Values encoding of CheckpointOpts.
+func (opts *CheckpointOpts) Encode() (string, error) {
+	vals, err := query.Values(opts)
+	if err != nil {
+		return "", err
+	}
+
+	return vals.Encode(), nil
+}
+
+// OrganizationInviter is who sent an invitation
+type OrganizationInviter struct {
+	Name string `json:"name"`
+}
+
+// OrganizationInvitee is who an invitation was sent to
+type OrganizationInvitee struct {
+	Email string `json:"email"`
+}
+
+// OrganizationInvitation is an invitation to join an organization
+type OrganizationInvitation struct {
+	ID             string               `json:"id,omitempty"`
+	OrganizationID string               `json:"organization_id,omitempty"`
+	Inviter        *OrganizationInviter `json:"inviter,omitempty"`
+	Invitee        *OrganizationInvitee `json:"invitee,omitempty"`
+	InvitationURL  string               `json:"invitation_url,omitempty"`
+	TicketID       string               `json:"ticket_id,omitempty"`
+	ClientID       string               `json:"client_id,omitempty"`
+	ConnectionID   string               `json:"connection_id,omitempty"`
+	AppMetadata    map[string]any       `json:"app_metadata,omitempty"`
+	UserMetadata   map[string]any       `json:"user_metadata,omitempty"`
+	Roles          []string             `json:"roles,omitempty"`
+	CreatedAt      string               `json:"created_at,omitempty"`
+	ExpiresAt      string               `json:"expires_at,omitempty"`
+}
+
+// OrganizationInvitationOpts are options which can be used to create an OrganizationInvitation
+type OrganizationInvitationOpts struct {
+	Inviter             OrganizationInviter `json:"inviter"`
+	Invitee             OrganizationInvitee `json:"invitee"`
+	ClientID            string              `json:"client_id"`
+	ConnectionID        string              `json:"connection_id,omitempty"`
+	AppMetadata         map[string]any      `json:"app_metadata,omitempty"`
+	UserMetadata        map[string]any      `json:"user_metadata,omitempty"`
+	TTLSeconds          int                 `json:"ttl_sec,omitempty"`
+	Roles               []string            `json:"roles,omitempty"`
+	SendInvitationEmail *bool               `json:"send_invitation_email,omitempty"`
+}
+
+// EnabledConnection is a connection members of an organization can log in with
+type EnabledConnection struct {
+	ConnectionID            string `json:"connection_id,omitempty"`
+	AssignMembershipOnLogin bool   `json:"assign_membership_on_login,omitempty"`
+	ShowAsButton            bool   `json:"show_as_button,omitempty"`
+	IsSignupEnabled         bool   `json:"is_signup_enabled,omitempty"`
+	Connection              *struct {
+		Name     string `json:"name,omitempty"`
+		Strategy string `json:"strategy,omitempty"`
+	} `json:"connection,omitempty"`
+}
+
+// EnabledConnectionOpts are options which can be used to enable a connection
+// for an organization, or to update an enabled connection. ConnectionID is
+// ignored on update.
+type EnabledConnectionOpts struct {
+	ConnectionID            string `json:"connection_id,omitempty"`
+	AssignMembershipOnLogin *bool  `json:"assign_membership_on_login,omitempty"`
+	ShowAsButton            *bool  `json:"show_as_button,omitempty"`
+	IsSignupEnabled         *bool  `json:"is_signup_enabled,omitempty"`
+}
+
+// GetAll returns all organizations
+func (svc *OrganizationsService) GetAll() ([]Organization, error) {
+	var organizations []Organization
+
+	err := svc.c.GetV2("/organizations", &organizations)
+
+	return organizations, err
+}
+
+// Get returns an organization
+func (svc *OrganizationsService) Get(orgID string) (Organization, error) {
+	var organization Organization
+
+	err := svc.c.Get("/organizations/"+orgID, &organization)
+
+	return organization, err
+}
+
+// GetByName returns an organization by its name
+func (svc *OrganizationsService) GetByName(name string) (Organization, error) {
+	var organization Organization
+
+	err := svc.c.Get("/organizations/name/"+url.PathEscape(name), &organization)
+
+	return organization, err
+}
+
+// Search retrieves organizations according to search criteria
+func (svc *OrganizationsService) Search(opts SearchOrganizationsOpts) (*OrganizationsPage, error) {
+	var organizationsPage OrganizationsPage
+
+	queryString, err := opts.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	url := "/organizations"
+	if queryString != "" {
+		url = "/organizations?" + queryString
+	}
+
+	if opts.IncludeTotals {
+		err = svc.c.Get(url, &organizationsPage)
+	} else {
+		err = svc.c.Get(url, &organizationsPage.Organizations)
+	}
+
+	return &organizationsPage, err
+}
+
+// Create creates an organization
+func (svc *OrganizationsService) Create(opts OrganizationOpts) (Organization, error) {
+	var organization Organization
+
+	err := svc.c.Post("/organizations", opts, &organization)
+
+	return organization, err
+}
+
+// Update updates an organization
+func (svc *OrganizationsService) Update(orgID string, opts OrganizationUpdateOpts) (Organization, error) {
+	var organization Organization
+
+	err := svc.c.Patch("/organizations/"+orgID, &opts, &organization)
+
+	return organization, err
+}
+
+// Delete deletes an organization
+func (svc *OrganizationsService) Delete(orgID string) error {
+	return svc.c.Delete("/organizations/"+orgID, nil, nil)
+}
+
+// maxMembersTake is the most members Auth0 returns for one checkpoint page
+const maxMembersTake = 100
+
+// GetMembers returns a page of an organization's members. Use the returned
+// Next as From to read the following page. Take defaults to 100.
+func (svc *OrganizationsService) GetMembers(orgID string, opts CheckpointOpts) (*OrganizationMembersPage, error) {
+	var membersPage OrganizationMembersPage
+
+	// Without take, Auth0 uses offset pagination and returns a bare array
+	if opts.Take <= 0 {
+		opts.Take = maxMembersTake
+	}
+
+	queryString, err := opts.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	url := "/organizations/" + orgID + "/members"
+	if queryString != "" {
+		url += "?" + queryString
+	}
+
+	err = svc.c.Get(url, &membersPage)
+
+	return &membersPage, err
+}
+
+// GetAllMembers returns all members of an organization
+func (svc *OrganizationsService) GetAllMembers(orgID string) ([]OrganizationMember, error) {
+	var members []OrganizationMember
+
+	err := svc.c.GetCheckpointV2("/organizations/"+orgID+"/members", &members)
+
+	return members, err
+}
+
+// AddMembers adds users to an organization
+func (svc *OrganizationsService) AddMembers(orgID string, userIDs []string) error {
+	body := membersBody{Members: userIDs}
+
+	return svc.c.Post("/organizations/"+orgID+"/members", &body, nil)
+}
+
+// RemoveMembers removes users from an organization
+func (svc *OrganizationsService) RemoveMembers(orgID string, userIDs []string) error {
+	body := membersBody{Members: userIDs}
+
+	return svc.c.Delete("/organizations/"+orgID+"/members", &body, nil)
+}
+
+// GetMemberRoles returns the roles a member has in an organization
+func (svc *OrganizationsService) GetMemberRoles(orgID, userID string) ([]Role, error) {
+	var roles []Role
+
+	err := svc.c.GetV2("/organizations/"+orgID+"/members/"+userID+"/roles", &roles)
+
+	return roles, err
+}
+
+// AssignMemberRoles assigns roles to a member of an organization
+func (svc *OrganizationsService) AssignMemberRoles(orgID, userID string, roleIDs []string) error {
+	body := rolesBody{Roles: roleIDs}
+
+	return svc.c.Post("/organizations/"+orgID+"/members/"+userID+"/roles", &body, nil)
+}
+
+// RemoveMemberRoles removes roles from a member of an organization
+func (svc *OrganizationsService) RemoveMemberRoles(orgID, userID string, roleIDs []string) error {
+	body := rolesBody{Roles: roleIDs}
+
+	return svc.c.Delete("/organizations/"+orgID+"/members/"+userID+"/roles", &body, nil)
+}
+
+// maxInvitationsPage is the largest page of invitations Auth0 returns
+const maxInvitationsPage = 100
+
+// GetInvitations returns all pending invitations of an organization. Auth0
+// doesn't return totals for invitations, so pages are read until one is short.
+func (svc *OrganizationsService) GetInvitations(orgID string) ([]OrganizationInvitation, error) {
+	var invitations []OrganizationInvitation
+
+	for page := 0; ; page++ {
+		var batch []OrganizationInvitation
+
+		endpoint := fmt.Sprintf("/organizations/%s/invitations?page=%d&per_page=%d", orgID, page, maxInvitationsPage)
+		if err := svc.c.Get(endpoint, &batch); err != nil {
+			return invitations, err
+		}
+
+		invitations = append(invitations, batch...)
+
+		if len(batch) < maxInvitationsPage {
+			return invitations, nil
+		}
+	}
+}
+
+// GetInvitation returns an invitation
+func (svc *OrganizationsService) GetInvitation(orgID, invitationID string) (OrganizationInvitation, error) {
+	var invitation OrganizationInvitation
+
+	err := svc.c.Get("/organizations/"+orgID+"/invitations/"+invitationID, &invitation)
+
+	return invitation, err
+}
+
+// CreateInvitation invites someone to join an organization
+func (svc *OrganizationsService) CreateInvitation(orgID string, opts OrganizationInvitationOpts) (OrganizationInvitation, error) {
+	var invitation OrganizationInvitation
+
+	err := svc.c.Post("/organizations/"+orgID+"/invitations", opts, &invitation)
+
+	return invitation, err
+}
+
+// DeleteInvitation deletes an invitation
+func (svc *OrganizationsService) DeleteInvitation(orgID, invitationID string) error {
+	return svc.c.Delete("/organizations/"+orgID+"/invitations/"+invitationID, nil, nil)
+}
+
+// GetEnabledConnections returns the connections enabled for an organization
+func (svc *OrganizationsService) GetEnabledConnections(orgID string) ([]EnabledConnection, error) {
+	var connections []EnabledConnection
+
+	err := svc.c.GetV2("/organizations/"+orgID+"/enabled_connections", &connections)
+
+	return connections, err
+}
+
+// GetEnabledConnection returns a connection enabled for an organization
+func (svc *OrganizationsService) GetEnabledConnection(orgID, connectionID string) (EnabledConnection, error) {
+	var connection EnabledConnection
+
+	err := svc.c.Get("/organizations/"+orgID+"/enabled_connections/"+connectionID, &connection)
+
+	return connection, err
+}
+
+// AddEnabledConnection enables a connection for an organization. With
+// AssignMembershipOnLogin, users logging in through the connection become
+// members automatically.
+func (svc *OrganizationsService) AddEnabledConnection(orgID string, opts EnabledConnectionOpts) (EnabledConnection, error) {
+	var connection EnabledConnection
+
+	err := svc.c.Post("/organizations/"+orgID+"/enabled_connections", opts, &connection)
+
+	return connection, err
+}
+
+// UpdateEnabledConnection updates a connection enabled for an organization
+func (svc *OrganizationsService) UpdateEnabledConnection(orgID, connectionID string, opts EnabledConnectionOpts) (EnabledConnection, error) {
+	var connection EnabledConnection
+
+	opts.ConnectionID = ""
+	err := svc.c.Patch("/organizations/"+orgID+"/enabled_connections/"+connectionID, &opts, &connection)
+
+	return connection, err
+}
+
+// RemoveEnabledConnection disables a connection for an organization
+func (svc *OrganizationsService) RemoveEnabledConnection(orgID, connectionID string) error {
+	return svc.c.Delete("/organizations/"+orgID+"/enabled_connections/"+connectionID, nil, nil)
+}
+
+type membersBody struct {
+	Members []string `json:"members"`
+}
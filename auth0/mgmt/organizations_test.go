@@ -0,0 +1,121 @@
+package mgmt_test
+
+import (
+	"fmt"
+	gohttp "net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zenoss/go-auth0/auth0/mgmt"
+)
+
+func TestOrganizationsGetAllMembers(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	first := expectRequest(t, mockDoer, gohttp.MethodGet, "/organizations/org_1/members",
+		`{"members": [{"user_id": "auth0|1"}, {"user_id": "auth0|2"}], "next": "cursor2"}`)
+	second := expectRequest(t, mockDoer, gohttp.MethodGet, "/organizations/org_1/members",
+		`{"members": [{"user_id": "auth0|3"}]}`)
+
+	members, err := svc.Organizations.GetAllMembers("org_1")
+	require.NoError(t, err)
+	assert.Equal(t, []mgmt.OrganizationMember{{ID: "auth0|1"}, {ID: "auth0|2"}, {ID: "auth0|3"}}, members)
+
+	assert.Equal(t, "100", first.Query.Get("take"))
+	assert.Empty(t, first.Query.Get("from"))
+	assert.Equal(t, "cursor2", second.Query.Get("from"))
+}
+
+func TestOrganizationsMembersPage(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	req := expectRequest(t, mockDoer, gohttp.MethodGet, "/organizations/org_1/members",
+		`{"members": [{"user_id": "auth0|1", "roles": [{"id": "rol_1", "name": "admin"}]}], "next": "cursor2"}`)
+
+	page, err := svc.Organizations.GetMembers("org_1", mgmt.CheckpointOpts{Take: 1, Fields: "user_id,roles"})
+	require.NoError(t, err)
+	assert.Equal(t, "cursor2", page.Next)
+	assert.Equal(t, "admin", page.Members[0].Roles[0].Name)
+	assert.Equal(t, "1", req.Query.Get("take"))
+
+	req = expectRequest(t, mockDoer, gohttp.MethodGet, "/organizations/org_1/members",
+		`{"members": [{"user_id": "auth0|1"}]}`)
+
+	page, err = svc.Organizations.GetMembers("org_1", mgmt.CheckpointOpts{})
+	require.NoError(t, err)
+	assert.Len(t, page.Members, 1)
+	assert.Empty(t, page.Next)
+	assert.Equal(t, "100", req.Query.Get("take"))
+
+	req = expectRequest(t, mockDoer, gohttp.MethodPost, "/organizations/org_1/members", "")
+	require.NoError(t, svc.Organizations.AddMembers("org_1", []string{"auth0|4"}))
+	assert.JSONEq(t, `{"members": ["auth0|4"]}`, string(req.Body))
+}
+
+func TestOrganizationsGetByName(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	expectRequest(t, mockDoer, gohttp.MethodGet, "/organizations/name/acme",
+		`{"id": "org_1", "name": "acme", "display_name": "Acme", "metadata": {"tenant": "acme"}}`)
+
+	org, err := svc.Organizations.GetByName("acme")
+	require.NoError(t, err)
+	assert.Equal(t, "org_1", org.ID)
+	assert.Equal(t, "acme", org.Metadata["tenant"])
+}
+
+func TestOrganizationsGetInvitations(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	full := make([]string, 100)
+	for i := range full {
+		full[i] = fmt.Sprintf(`{"id": "uinv_%d"}`, i)
+	}
+
+	first := expectRequest(t, mockDoer, gohttp.MethodGet, "/organizations/org_1/invitations", "["+strings.Join(full, ",")+"]")
+	second := expectRequest(t, mockDoer, gohttp.MethodGet, "/organizations/org_1/invitations", `[{"id": "uinv_100"}]`)
+
+	invitations, err := svc.Organizations.GetInvitations("org_1")
+	require.NoError(t, err)
+	require.Len(t, invitations, 101)
+	assert.Equal(t, "uinv_100", invitations[100].ID)
+	assert.Equal(t, "0", first.Query.Get("page"))
+	assert.Equal(t, "1", second.Query.Get("page"))
+	assert.Equal(t, "100", second.Query.Get("per_page"))
+}
+
+func TestOrganizationsInvitationsAndConnections(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	req := expectRequest(t, mockDoer, gohttp.MethodPost, "/organizations/org_1/invitations",
+		`{"id": "uinv_1", "invitation_url": "https://example.com/login?invitation=abc"}`)
+
+	invitation, err := svc.Organizations.CreateInvitation("org_1", mgmt.OrganizationInvitationOpts{
+		Inviter:    mgmt.OrganizationInviter{Name: "Admin"},
+		Invitee:    mgmt.OrganizationInvitee{Email: "someone@example.com"},
+		ClientID:   "abc123",
+		TTLSeconds: 3600,
+		Roles:      []string{"rol_1"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "uinv_1", invitation.ID)
+	assert.JSONEq(t, `{
+		"inviter": {"name": "Admin"},
+		"invitee": {"email": "someone@example.com"},
+		"client_id": "abc123",
+		"ttl_sec": 3600,
+		"roles": ["rol_1"]
+	}`, string(req.Body))
+
+	req = expectRequest(t, mockDoer, gohttp.MethodPatch, "/organizations/org_1/enabled_connections/con_1",
+		`{"connection_id": "con_1", "assign_membership_on_login": false}`)
+
+	conn, err := svc.Organizations.UpdateEnabledConnection("org_1", "con_1", mgmt.EnabledConnectionOpts{
+		AssignMembershipOnLogin: mgmt.Bool(false),
+	})
+	require.NoError(t, err)
+	assert.False(t, conn.AssignMembershipOnLogin)
+	assert.JSONEq(t, `{"assign_membership_on_login": false}`, string(req.Body))
+}
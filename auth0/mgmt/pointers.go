@@ -0,0 +1,25 @@
+package mgmt
+
+// Bool returns a pointer to v, for optional fields of options structs
+func Bool(v bool) *bool {
+	return &v
+}
+
+// String returns a pointer to v, for optional fields of options structs
+func String(v string) *string {
+	return &v
+}
+
+// Int returns a pointer to v, for optional fields of options structs
+func Int(v int) *int {
+	return &v
+}
+
+// Strings returns a pointer to v, for optional fields of options structs
+func Strings(v ...string) *[]string {
+	if v == nil {
+		v = []string{}
+	}
+
+	return &v
+}
@@ -0,0 +1,210 @@
+package mgmt
+
+import (
+	"net/url"
+	"slices"
+
+	"github.com/google/go-querystring/query"
+
+	"github.com/zenoss/go-auth0/auth0/http"
+)
+
+// Token dialects of a resource server. The _authz dialects include the
+// permissions claim when RBAC policies are enforced.
+const (
+	TokenDialectAccessToken         = "access_token"
+	TokenDialectAccessTokenAuthz    = "access_token_authz"
+	TokenDialectRFC9068Profile      = "rfc9068_profile"
+	TokenDialectRFC9068ProfileAuthz = "rfc9068_profile_authz"
+)
+
+// ResourceServersService provides a service for resource server (API) related functions
+type ResourceServersService struct {
+	c *http.Client
+}
+
+// ResourceServerScope is a scope (permission) defined by a resource server
+type ResourceServerScope struct {
+	Value       string `json:"value"`
+	Description string `json:"description,omitempty"`
+}
+
+// ResourceServer is a resource server (API) in Auth0
+type ResourceServer struct {
+	ID                                        string                `json:"id,omitempty"`
+	Name                                      string                `json:"name,omitempty"`
+	Identifier                                string                `json:"identifier,omitempty"`
+	Scopes                                    []ResourceServerScope `json:"scopes,omitempty"`
+	SigningAlgorithm                          string                `json:"signing_alg,omitempty"`
+	SigningSecret                             string                `json:"signing_secret,omitempty"`
+	AllowOfflineAccess                        bool                  `json:"allow_offline_access,omitempty"`
+	SkipConsentForVerifiableFirstPartyClients bool                  `json:"skip_consent_for_verifiable_first_party_clients,omitempty"`
+	TokenLifetime                             int                   `json:"token_lifetime,omitempty"`
+	TokenLifetimeForWeb                       int                   `json:"token_lifetime_for_web,omitempty"`
+	EnforcePolicies                           bool                  `json:"enforce_policies,omitempty"`
+	TokenDialect                              string                `json:"token_dialect,omitempty"`
+	IsSystem                                  bool                  `json:"is_system,omitempty"`
+}
+
+// ResourceServerOpts are options which can be used to create a ResourceServer
+type ResourceServerOpts struct {
+	Name                                      string                `json:"name,omitempty"`
+	Identifier                                string                `json:"identifier"`
+	Scopes                                    []ResourceServerScope `json:"scopes,omitempty"`
+	SigningAlgorithm                          string                `json:"signing_alg,omitempty"`
+	SigningSecret                             string                `json:"signing_secret,omitempty"`
+	AllowOfflineAccess                        *bool                 `json:"allow_offline_access,omitempty"`
+	SkipConsentForVerifiableFirstPartyClients *bool                 `json:"skip_consent_for_verifiable_first_party_clients,omitempty"`
+	TokenLifetime                             int                   `json:"token_lifetime,omitempty"`
+	TokenLifetimeForWeb                       int                   `json:"token_lifetime_for_web,omitempty"`
+	EnforcePolicies                           *bool                 `json:"enforce_policies,omitempty"`
+	TokenDialect                              string                `json:"token_dialect,omitempty"`
+}
+
+// ResourceServerUpdateOpts are options which can be used to update a
+// ResourceServer. Fields left nil or empty are not changed; Scopes replaces
+// every scope, see AddScopes and RemoveScopes to change individual ones.
+type ResourceServerUpdateOpts struct {
+	Name                                      string                 `json:"name,omitempty"`
+	Scopes                                    *[]ResourceServerScope `json:"scopes,omitempty"`
+	SigningAlgorithm                          string                 `json:"signing_alg,omitempty"`
+	SigningSecret                             string                 `json:"signing_secret,omitempty"`
+	AllowOfflineAccess                        *bool                  `json:"allow_offline_access,omitempty"`
+	SkipConsentForVerifiableFirstPartyClients *bool                  `json:"skip_consent_for_verifiable_first_party_clients,omitempty"`
+	TokenLifetime                             int                    `json:"token_lifetime,omitempty"`
+	TokenLifetimeForWeb                       int                    `json:"token_lifetime_for_web,omitempty"`
+	EnforcePolicies                           *bool                  `json:"enforce_policies,omitempty"`
+	TokenDialect                              string                 `json:"token_dialect,omitempty"`
+}
+
+type ResourceServersPage struct {
+	Start           int              `json:"start,omitempty"`
+	Limit           int              `json:"limit,omitempty"`
+	Length          int              `json:"length,omitempty"`
+	Total           int              `json:"total,omitempty"`
+	ResourceServers []ResourceServer `json:"resource_servers,omitempty"`
+}
+
+// SearchResourceServersOpts defines what can be used to search resource servers
+type SearchResourceServersOpts struct {
+	PerPage       int      `url:"per_page,omitempty"`
+	Page          int      `url:"page,omitempty"`
+	IncludeTotals bool     `url:"include_totals,omitempty"`
+	Identifiers   []string `url:"identifiers,omitempty"`
+}
+
+// Encode creates a url.Values encoding of SearchResourceServersOpts.
+func (opts *SearchResourceServersOpts) Encode() (string, error) {
+	vals, err := query.Values(opts)
+	if err != nil {
+		return "", err
+	}
+
+	return vals.Encode(), nil
+}
+
+// GetAll returns all resource servers
+func (svc *ResourceServersService) GetAll() ([]ResourceServer, error) {
+	var resourceServers []ResourceServer
+
+	err := svc.c.GetV2("/resource-servers", &resourceServers)
+
+	return resourceServers, err
+}
+
+// Get returns a resource server by its ID or identifier (audience)
+func (svc *ResourceServersService) Get(id string) (ResourceServer, error) {
+	var resourceServer ResourceServer
+
+	err := svc.c.Get("/resource-servers/"+url.PathEscape(id), &resourceServer)
+
+	return resourceServer, err
+}
+
+// Search retrieves resource servers according to search criteria
+func (svc *ResourceServersService) Search(opts SearchResourceServersOpts) (*ResourceServersPage, error) {
+	var resourceServersPage ResourceServersPage
+
+	queryString, err := opts.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	url := "/resource-servers"
+	if queryString != "" {
+		url = "/resource-servers?" + queryString
+	}
+
+	if opts.IncludeTotals {
+		err = svc.c.Get(url, &resourceServersPage)
+	} else {
+		err = svc.c.Get(url, &resourceServersPage.ResourceServers)
+	}
+
+	return &resourceServersPage, err
+}
+
+// Create creates a resource server
+func (svc *ResourceServersService) Create(opts ResourceServerOpts) (ResourceServer, error) {
+	var resourceServer ResourceServer
+
+	err := svc.c.Post("/resource-servers", opts, &resourceServer)
+
+	return resourceServer, err
+}
+
+// Update updates a resource server by its ID or identifier
+func (svc *ResourceServersService) Update(id string, opts ResourceServerUpdateOpts) (ResourceServer, error) {
+	var resourceServer ResourceServer
+
+	err := svc.c.Patch("/resource-servers/"+url.PathEscape(id), &opts, &resourceServer)
+
+	return resourceServer, err
+}
+
+// Delete deletes a resource server by its ID or identifier
+func (svc *ResourceServersService) Delete(id string) error {
+	return svc.c.Delete("/resource-servers/"+url.PathEscape(id), nil, nil)
+}
+
+// AddScopes adds scopes to a resource server, keeping the ones it already has.
+// A scope whose value already exists has its description replaced. The API
+// only supports replacing all scopes, so concurrent changes to the same
+// resource server can still overwrite each other.
+func (svc *ResourceServersService) AddScopes(id string, scopes ...ResourceServerScope) (ResourceServer, error) {
+	resourceServer, err := svc.Get(id)
+	if err != nil {
+		return resourceServer, err
+	}
+
+	merged := slices.Clone(resourceServer.Scopes)
+
+	for _, scope := range scopes {
+		i := slices.IndexFunc(merged, func(s ResourceServerScope) bool { return s.Value == scope.Value })
+		if i < 0 {
+			merged = append(merged, scope)
+		} else {
+			merged[i] = scope
+		}
+	}
+
+	return svc.Update(id, ResourceServerUpdateOpts{Scopes: &merged})
+}
+
+// RemoveScopes removes the scopes with the given values from a resource
+// server, keeping the others. See AddScopes about concurrent changes.
+func (svc *ResourceServersService) RemoveScopes(id string, values ...string) (ResourceServer, error) {
+	resourceServer, err := svc.Get(id)
+	if err != nil {
+		return resourceServer, err
+	}
+
+	remaining := slices.DeleteFunc(slices.Clone(resourceServer.Scopes), func(s ResourceServerScope) bool {
+		return slices.Contains(values, s.Value)
+	})
+	if remaining == nil {
+		remaining = []ResourceServerScope{}
+	}
+
+	return svc.Update(id, ResourceServerUpdateOpts{Scopes: &remaining})
+}
@@ -0,0 +1,85 @@
+package mgmt_test
+
+import (
+	gohttp "net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zenoss/go-auth0/auth0/mgmt"
+)
+
+const testResourceServerJSON = `{
+  "id": "rs1",
+  "name": "Devices API",
+  "identifier": "https://devices.example.com",
+  "signing_alg": "RS256",
+  "enforce_policies": true,
+  "token_dialect": "access_token_authz",
+  "scopes": [
+    {"value": "read:devices", "description": "Read devices"},
+    {"value": "write:devices", "description": "Write devices"}
+  ]
+}`
+
+func TestResourceServersGetByIdentifier(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	expectRequest(t, mockDoer, gohttp.MethodGet, "/resource-servers/https://devices.example.com", testResourceServerJSON)
+
+	rs, err := svc.ResourceServers.Get("https://devices.example.com")
+	require.NoError(t, err)
+	assert.True(t, rs.EnforcePolicies)
+	assert.Equal(t, mgmt.TokenDialectAccessTokenAuthz, rs.TokenDialect)
+	assert.Len(t, rs.Scopes, 2)
+}
+
+func TestResourceServersAddRemoveScopes(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	expectRequest(t, mockDoer, gohttp.MethodGet, "/resource-servers/rs1", testResourceServerJSON)
+	req := expectRequest(t, mockDoer, gohttp.MethodPatch, "/resource-servers/rs1", testResourceServerJSON)
+
+	_, err := svc.ResourceServers.AddScopes("rs1",
+		mgmt.ResourceServerScope{Value: "delete:devices", Description: "Delete devices"},
+		mgmt.ResourceServerScope{Value: "read:devices", Description: "Read all devices"},
+	)
+	require.NoError(t, err)
+
+	var added mgmt.ResourceServerUpdateOpts
+	req.decodeBody(t, &added)
+	assert.Equal(t, []mgmt.ResourceServerScope{
+		{Value: "read:devices", Description: "Read all devices"},
+		{Value: "write:devices", Description: "Write devices"},
+		{Value: "delete:devices", Description: "Delete devices"},
+	}, *added.Scopes)
+
+	expectRequest(t, mockDoer, gohttp.MethodGet, "/resource-servers/rs1", testResourceServerJSON)
+	req = expectRequest(t, mockDoer, gohttp.MethodPatch, "/resource-servers/rs1", testResourceServerJSON)
+
+	_, err = svc.ResourceServers.RemoveScopes("rs1", "read:devices", "write:devices")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"scopes": []}`, string(req.Body))
+}
+
+func TestResourceServersCreate(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	req := expectRequest(t, mockDoer, gohttp.MethodPost, "/resource-servers", testResourceServerJSON)
+
+	_, err := svc.ResourceServers.Create(mgmt.ResourceServerOpts{
+		Name:            "Devices API",
+		Identifier:      "https://devices.example.com",
+		EnforcePolicies: mgmt.Bool(true),
+		TokenDialect:    mgmt.TokenDialectAccessTokenAuthz,
+		TokenLifetime:   86400,
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"name": "Devices API",
+		"identifier": "https://devices.example.com",
+		"enforce_policies": true,
+		"token_dialect": "access_token_authz",
+		"token_lifetime": 86400
+	}`, string(req.Body))
+}
@@ -0,0 +1,215 @@
+package mgmt
+
+import (
+	"github.com/google/go-querystring/query"
+
+	"github.com/zenoss/go-auth0/auth0/http"
+)
+
+// RolesService provides a service for RBAC role related functions
+type RolesService struct {
+	c *http.Client
+}
+
+// Role is an RBAC role in Auth0
+type Role struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// RoleOpts are options which can be used to create a Role
+type RoleOpts struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// RoleUpdateOpts are options which can be used to update a Role
+type RoleUpdateOpts struct {
+	Name        string  `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// Permission is a permission (scope) of a resource server, as granted to a
+// role or a user
+type Permission struct {
+	ResourceServerIdentifier string             `json:"resource_server_identifier"`
+	Name                     string             `json:"permission_name"`
+	ResourceServerName       string             `json:"resource_server_name,omitempty"`
+	Description              string             `json:"description,omitempty"`
+	Sources                  []PermissionSource `json:"sources,omitempty"`
+}
+
+// PermissionSource tells whether a user's permission was granted directly or
+// through a role
+type PermissionSource struct {
+	SourceID   string `json:"source_id,omitempty"`
+	SourceName string `json:"source_name,omitempty"`
+	SourceType string `json:"source_type,omitempty"`
+}
+
+// RoleUser is a user assigned to a role
+type RoleUser struct {
+	ID      string `json:"user_id,omitempty"`
+	Email   string `json:"email,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Picture string `json:"picture,omitempty"`
+}
+
+type RolesPage struct {
+	Start  int    `json:"start,omitempty"`
+	Limit  int    `json:"limit,omitempty"`
+	Length int    `json:"length,omitempty"`
+	Total  int    `json:"total,omitempty"`
+	Roles  []Role `json:"roles,omitempty"`
+}
+
+// SearchRolesOpts defines what can be used to search roles
+type SearchRolesOpts struct {
+	PerPage       int    `url:"per_page,omitempty"`
+	Page          int    `url:"page,omitempty"`
+	IncludeTotals bool   `url:"include_totals,omitempty"`
+	NameFilter    string `url:"name_filter,omitempty"`
+}
+
+// Encode creates a url.Values encoding of SearchRolesOpts.
+func (opts *SearchRolesOpts) Encode() (string, error) {
+	vals, err := query.Values(opts)
+	if err != nil {
+		return "", err
+	}
+
+	return vals.Encode(), nil
+}
+
+// GetAll returns all roles
+func (svc *RolesService) GetAll() ([]Role, error) {
+	var roles []Role
+
+	err := svc.c.GetV2("/roles", &roles)
+
+	return roles, err
+}
+
+// Get returns a role
+func (svc *RolesService) Get(roleID string) (Role, error) {
+	var role Role
+
+	err := svc.c.Get("/roles/"+roleID, &role)
+
+	return role, err
+}
+
+// Search retrieves roles according to search criteria
+func (svc *RolesService) Search(opts SearchRolesOpts) (*RolesPage, error) {
+	var rolesPage RolesPage
+
+	queryString, err := opts.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	url := "/roles"
+	if queryString != "" {
+		url = "/roles?" + queryString
+	}
+
+	if opts.IncludeTotals {
+		err = svc.c.Get(url, &rolesPage)
+	} else {
+		err = svc.c.Get(url, &rolesPage.Roles)
+	}
+
+	return &rolesPage, err
+}
+
+// Create creates a role
+func (svc *RolesService) Create(opts RoleOpts) (Role, error) {
+	var role Role
+
+	err := svc.c.Post("/roles", opts, &role)
+
+	return role, err
+}
+
+// Update updates a role
+func (svc *RolesService) Update(roleID string, opts RoleUpdateOpts) (Role, error) {
+	var role Role
+
+	err := svc.c.Patch("/roles/"+roleID, &opts, &role)
+
+	return role, err
+}
+
+// Delete deletes a role
+func (svc *RolesService) Delete(roleID string) error {
+	return svc.c.Delete("/roles/"+roleID, nil, nil)
+}
+
+// GetPermissions returns all permissions granted to a role
+func (svc *RolesService) GetPermissions(roleID string) ([]Permission, error) {
+	var permissions []Permission
+
+	err := svc.c.GetV2("/roles/"+roleID+"/permissions", &permissions)
+
+	return permissions, err
+}
+
+// AddPermissions grants permissions to a role
+func (svc *RolesService) AddPermissions(roleID string, permissions []Permission) error {
+	body := newPermissionsBody(permissions)
+
+	return svc.c.Post("/roles/"+roleID+"/permissions", &body, nil)
+}
+
+// RemovePermissions removes permissions from a role
+func (svc *RolesService) RemovePermissions(roleID string, permissions []Permission) error {
+	body := newPermissionsBody(permissions)
+
+	return svc.c.Delete("/roles/"+roleID+"/permissions", &body, nil)
+}
+
+// GetUsers returns all users assigned to a role
+func (svc *RolesService) GetUsers(roleID string) ([]RoleUser, error) {
+	var users []RoleUser
+
+	err := svc.c.GetV2("/roles/"+roleID+"/users", &users)
+
+	return users, err
+}
+
+// AssignUsers assigns users to a role
+func (svc *RolesService) AssignUsers(roleID string, userIDs []string) error {
+	body := struct {
+		Users []string `json:"users"`
+	}{Users: userIDs}
+
+	return svc.c.Post("/roles/"+roleID+"/users", &body, nil)
+}
+
+// permissionRef identifies a permission in the body of a request; Auth0
+// rejects the other fields of Permission there
+type permissionRef struct {
+	ResourceServerIdentifier string `json:"resource_server_identifier"`
+	Name                     string `json:"permission_name"`
+}
+
+type permissionsBody struct {
+	Permissions []permissionRef `json:"permissions"`
+}
+
+func newPermissionsBody(permissions []Permission) permissionsBody {
+	refs := make([]permissionRef, len(permissions))
+	for i, permission := range permissions {
+		refs[i] = permissionRef{
+			ResourceServerIdentifier: permission.ResourceServerIdentifier,
+			Name:                     permission.Name,
+		}
+	}
+
+	return permissionsBody{Permissions: refs}
+}
+
+type rolesBody struct {
+	Roles []string `json:"roles"`
+}
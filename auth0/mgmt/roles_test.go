@@ -0,0 +1,89 @@
+package mgmt_test
+
+import (
+	gohttp "net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zenoss/go-auth0/auth0/mgmt"
+)
+
+func TestRolesPermissions(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	expectRequest(t, mockDoer, gohttp.MethodGet, "/roles/rol_1/permissions", `{
+		"start": 0, "limit": 100, "total": 1,
+		"permissions": [{
+			"resource_server_identifier": "https://devices.example.com",
+			"permission_name": "read:devices",
+			"resource_server_name": "Devices API",
+			"description": "Read devices"
+		}]
+	}`)
+
+	permissions, err := svc.Roles.GetPermissions("rol_1")
+	require.NoError(t, err)
+	require.Len(t, permissions, 1)
+	assert.Equal(t, "read:devices", permissions[0].Name)
+	assert.Equal(t, "https://devices.example.com", permissions[0].ResourceServerIdentifier)
+
+	req := expectRequest(t, mockDoer, gohttp.MethodPost, "/roles/rol_1/permissions", "")
+	err = svc.Roles.AddPermissions("rol_1", []mgmt.Permission{
+		{ResourceServerIdentifier: "https://devices.example.com", Name: "write:devices"},
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"permissions": [{
+		"resource_server_identifier": "https://devices.example.com",
+		"permission_name": "write:devices"
+	}]}`, string(req.Body))
+}
+
+func TestUsersRemovePermissionsFromGetPermissions(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	req := expectRequest(t, mockDoer, gohttp.MethodDelete, "/users/auth0|user1/permissions", "")
+
+	// Permissions read back from Auth0 only send the fields the endpoint accepts
+	err := svc.Users.RemovePermissions("auth0|user1", []mgmt.Permission{{
+		ResourceServerIdentifier: "https://devices.example.com",
+		Name:                     "read:devices",
+		ResourceServerName:       "Devices API",
+		Description:              "Read devices",
+		Sources:                  []mgmt.PermissionSource{{SourceType: "DIRECT"}},
+	}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"permissions": [{
+		"resource_server_identifier": "https://devices.example.com",
+		"permission_name": "read:devices"
+	}]}`, string(req.Body))
+}
+
+func TestUsersRoles(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	expectRequest(t, mockDoer, gohttp.MethodGet, "/users/auth0|user1/roles",
+		`{"start": 0, "limit": 100, "total": 2, "roles": [{"id": "rol_1", "name": "viewer"}, {"id": "rol_2", "name": "admin"}]}`)
+
+	roles, err := svc.Users.GetRoles("auth0|user1")
+	require.NoError(t, err)
+	assert.Equal(t, []mgmt.Role{{ID: "rol_1", Name: "viewer"}, {ID: "rol_2", Name: "admin"}}, roles)
+
+	req := expectRequest(t, mockDoer, gohttp.MethodDelete, "/users/auth0|user1/roles", "")
+	require.NoError(t, svc.Users.RemoveRoles("auth0|user1", []string{"rol_2"}))
+	assert.JSONEq(t, `{"roles": ["rol_2"]}`, string(req.Body))
+
+	expectRequest(t, mockDoer, gohttp.MethodGet, "/users/auth0|user1/permissions", `{
+		"total": 1,
+		"permissions": [{
+			"resource_server_identifier": "https://devices.example.com",
+			"permission_name": "read:devices",
+			"sources": [{"source_id": "rol_1", "source_name": "viewer", "source_type": "ROLE"}]
+		}]
+	}`)
+
+	permissions, err := svc.Users.GetPermissions("auth0|user1")
+	require.NoError(t, err)
+	require.Len(t, permissions, 1)
+	assert.Equal(t, "ROLE", permissions[0].Sources[0].SourceType)
+}
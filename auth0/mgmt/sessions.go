@@ -0,0 +1,141 @@
+package mgmt
+
+import (
+	"github.com/zenoss/go-auth0/auth0/http"
+)
+
+// SessionsService provides a service for session related functions
+type SessionsService struct {
+	c *http.Client
+}
+
+// RefreshTokensService provides a service for refresh token related functions
+type RefreshTokensService struct {
+	c *http.Client
+}
+
+// SessionDevice describes the device a session or refresh token was created
+// and last used from
+type SessionDevice struct {
+	InitialUserAgent string `json:"initial_user_agent,omitempty"`
+	InitialIP        string `json:"initial_ip,omitempty"`
+	InitialASN       string `json:"initial_asn,omitempty"`
+	LastUserAgent    string `json:"last_user_agent,omitempty"`
+	LastIP           string `json:"last_ip,omitempty"`
+	LastASN          string `json:"last_asn,omitempty"`
+}
+
+// SessionClient is an application a session was used with
+type SessionClient struct {
+	ClientID string `json:"client_id,omitempty"`
+}
+
+// SessionAuthenticationMethod is how a user authenticated in a session
+type SessionAuthenticationMethod struct {
+	Name      string `json:"name,omitempty"`
+	Type      string `json:"type,omitempty"`
+	Timestamp string `json:"timestamp,omitempty"`
+}
+
+// SessionAuthentication lists how a user authenticated in a session
+type SessionAuthentication struct {
+	Methods []SessionAuthenticationMethod `json:"methods,omitempty"`
+}
+
+// Session is a login session of a user
+type Session struct {
+	ID               string                 `json:"id,omitempty"`
+	UserID           string                 `json:"user_id,omitempty"`
+	CreatedAt        string                 `json:"created_at,omitempty"`
+	UpdatedAt        string                 `json:"updated_at,omitempty"`
+	AuthenticatedAt  string                 `json:"authenticated_at,omitempty"`
+	IdleExpiresAt    string                 `json:"idle_expires_at,omitempty"`
+	ExpiresAt        string                 `json:"expires_at,omitempty"`
+	LastInteractedAt string                 `json:"last_interacted_at,omitempty"`
+	Device           *SessionDevice         `json:"device,omitempty"`
+	Clients          []SessionClient        `json:"clients,omitempty"`
+	Authentication   *SessionAuthentication `json:"authentication,omitempty"`
+}
+
+// RefreshTokenResourceServer is an API a refresh token grants access to
+type RefreshTokenResourceServer struct {
+	Audience string `json:"audience,omitempty"`
+	Scopes   string `json:"scopes,omitempty"`
+}
+
+// RefreshToken is a refresh token issued to a user
+type RefreshToken struct {
+	ID              string                       `json:"id,omitempty"`
+	UserID          string                       `json:"user_id,omitempty"`
+	ClientID        string                       `json:"client_id,omitempty"`
+	SessionID       string                       `json:"session_id,omitempty"`
+	Rotating        bool                         `json:"rotating,omitempty"`
+	CreatedAt       string                       `json:"created_at,omitempty"`
+	IdleExpiresAt   string                       `json:"idle_expires_at,omitempty"`
+	ExpiresAt       string                       `json:"expires_at,omitempty"`
+	LastExchangedAt string                       `json:"last_exchanged_at,omitempty"`
+	Device          *SessionDevice               `json:"device,omitempty"`
+	ResourceServers []RefreshTokenResourceServer `json:"resource_servers,omitempty"`
+}
+
+// Get returns a session
+func (svc *SessionsService) Get(sessionID string) (Session, error) {
+	var session Session
+
+	err := svc.c.Get("/sessions/"+sessionID, &session)
+
+	return session, err
+}
+
+// Delete deletes a session. Refresh tokens issued in the session remain
+// valid; use Revoke to also revoke them.
+func (svc *SessionsService) Delete(sessionID string) error {
+	return svc.c.Delete("/sessions/"+sessionID, nil, nil)
+}
+
+// Revoke deletes a session and revokes the refresh tokens issued in it
+func (svc *SessionsService) Revoke(sessionID string) error {
+	return svc.c.Post("/sessions/"+sessionID+"/revoke", struct{}{}, nil)
+}
+
+// Get returns a refresh token
+func (svc *RefreshTokensService) Get(refreshTokenID string) (RefreshToken, error) {
+	var refreshToken RefreshToken
+
+	err := svc.c.Get("/refresh-tokens/"+refreshTokenID, &refreshToken)
+
+	return refreshToken, err
+}
+
+// Delete revokes a refresh token
+func (svc *RefreshTokensService) Delete(refreshTokenID string) error {
+	return svc.c.Delete("/refresh-tokens/"+refreshTokenID, nil, nil)
+}
+
+// GetSessions returns all sessions of a user
+func (svc *UsersService) GetSessions(userID string) ([]Session, error) {
+	var sessions []Session
+
+	err := svc.c.GetCheckpointV2("/users/"+userID+"/sessions", &sessions)
+
+	return sessions, err
+}
+
+// DeleteSessions deletes all sessions of a user
+func (svc *UsersService) DeleteSessions(userID string) error {
+	return svc.c.Delete("/users/"+userID+"/sessions", nil, nil)
+}
+
+// GetRefreshTokens returns all refresh tokens of a user
+func (svc *UsersService) GetRefreshTokens(userID string) ([]RefreshToken, error) {
+	var refreshTokens []RefreshToken
+
+	err := svc.c.GetCheckpointKeyV2("/users/"+userID+"/refresh-tokens", "tokens", &refreshTokens)
+
+	return refreshTokens, err
+}
+
+// DeleteRefreshTokens revokes all refresh tokens of a user
+func (svc *UsersService) DeleteRefreshTokens(userID string) error {
+	return svc.c.Delete("/users/"+userID+"/refresh-tokens", nil, nil)
+}
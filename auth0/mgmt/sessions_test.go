@@ -0,0 +1,48 @@
+package mgmt_test
+
+import (
+	gohttp "net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsersSessions(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	expectRequest(t, mockDoer, gohttp.MethodGet, "/users/auth0|user1/sessions", `{
+		"sessions": [{"id": "ses_1", "device": {"last_user_agent": "Firefox", "last_ip": "10.0.0.1"}}],
+		"next": "cursor2"
+	}`)
+	expectRequest(t, mockDoer, gohttp.MethodGet, "/users/auth0|user1/sessions",
+		`{"sessions": [{"id": "ses_2", "clients": [{"client_id": "abc123"}]}]}`)
+
+	sessions, err := svc.Users.GetSessions("auth0|user1")
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	assert.Equal(t, "Firefox", sessions[0].Device.LastUserAgent)
+	assert.Equal(t, "abc123", sessions[1].Clients[0].ClientID)
+
+	expectRequest(t, mockDoer, gohttp.MethodPost, "/sessions/ses_1/revoke", "")
+	require.NoError(t, svc.Sessions.Revoke("ses_1"))
+}
+
+func TestUsersRefreshTokens(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	req := expectRequest(t, mockDoer, gohttp.MethodGet, "/users/auth0|user1/refresh-tokens", `{
+		"tokens": [{"id": "rt_1", "session_id": "ses_1", "rotating": true,
+			"resource_servers": [{"audience": "https://api.example.com", "scopes": "read:devices"}]}]
+	}`)
+
+	tokens, err := svc.Users.GetRefreshTokens("auth0|user1")
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, "ses_1", tokens[0].SessionID)
+	assert.Equal(t, "https://api.example.com", tokens[0].ResourceServers[0].Audience)
+	assert.Equal(t, "100", req.Query.Get("take"))
+
+	expectRequest(t, mockDoer, gohttp.MethodDelete, "/refresh-tokens/rt_1", "")
+	require.NoError(t, svc.RefreshTokens.Delete("rt_1"))
+}
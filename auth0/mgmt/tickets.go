@@ -0,0 +1,86 @@
+package mgmt
+
+import (
+	"github.com/zenoss/go-auth0/auth0/http"
+)
+
+// TicketsService provides a service for ticket related functions
+type TicketsService struct {
+	c *http.Client
+}
+
+// Ticket is a link a user can follow to complete an action
+type Ticket struct {
+	URL string `json:"ticket"`
+}
+
+// TicketIdentity selects one of the identities of a user, for users with
+// linked accounts
+type TicketIdentity struct {
+	UserID   string `json:"user_id"`
+	Provider string `json:"provider"`
+}
+
+// PasswordChangeTicketOpts are options which can be used to create a password
+// change ticket. Either UserID, or Email and ConnectionID, identify the user.
+// ResultURL and ClientID are mutually exclusive.
+type PasswordChangeTicketOpts struct {
+	UserID                 string `json:"user_id,omitempty"`
+	Email                  string `json:"email,omitempty"`
+	ConnectionID           string `json:"connection_id,omitempty"`
+	ResultURL              string `json:"result_url,omitempty"`
+	ClientID               string `json:"client_id,omitempty"`
+	OrganizationID         string `json:"organization_id,omitempty"`
+	TTLSeconds             int    `json:"ttl_sec,omitempty"`
+	MarkEmailAsVerified    *bool  `json:"mark_email_as_verified,omitempty"`
+	IncludeEmailInRedirect *bool  `json:"includeEmailInRedirect,omitempty"`
+}
+
+// EmailVerificationTicketOpts are options which can be used to create an
+// email verification ticket
+type EmailVerificationTicketOpts struct {
+	UserID                 string          `json:"user_id"`
+	ResultURL              string          `json:"result_url,omitempty"`
+	ClientID               string          `json:"client_id,omitempty"`
+	OrganizationID         string          `json:"organization_id,omitempty"`
+	TTLSeconds             int             `json:"ttl_sec,omitempty"`
+	IncludeEmailInRedirect *bool           `json:"includeEmailInRedirect,omitempty"`
+	Identity               *TicketIdentity `json:"identity,omitempty"`
+}
+
+// VerificationEmailOpts are options which can be used to send a verification
+// email
+type VerificationEmailOpts struct {
+	UserID         string          `json:"user_id"`
+	ClientID       string          `json:"client_id,omitempty"`
+	OrganizationID string          `json:"organization_id,omitempty"`
+	Identity       *TicketIdentity `json:"identity,omitempty"`
+}
+
+// PasswordChange creates a password change ticket
+func (svc *TicketsService) PasswordChange(opts PasswordChangeTicketOpts) (Ticket, error) {
+	var ticket Ticket
+
+	err := svc.c.Post("/tickets/password-change", opts, &ticket)
+
+	return ticket, err
+}
+
+// EmailVerification creates an email verification ticket
+func (svc *TicketsService) EmailVerification(opts EmailVerificationTicketOpts) (Ticket, error) {
+	var ticket Ticket
+
+	err := svc.c.Post("/tickets/email-verification", opts, &ticket)
+
+	return ticket, err
+}
+
+// SendVerificationEmail sends a user the verification email again. The email
+// is sent by a job; use Wait to know when it is sent.
+func (svc *JobsService) SendVerificationEmail(opts VerificationEmailOpts) (Job, error) {
+	var job Job
+
+	err := svc.c.Post("/jobs/verification-email", opts, &job)
+
+	return job, err
+}
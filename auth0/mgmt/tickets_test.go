@@ -0,0 +1,59 @@
+package mgmt_test
+
+import (
+	gohttp "net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zenoss/go-auth0/auth0/mgmt"
+)
+
+func TestTicketsPasswordChange(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	req := expectRequest(t, mockDoer, gohttp.MethodPost, "/tickets/password-change",
+		`{"ticket": "https://example.auth0.com/lo/reset?ticket=abc#"}`)
+
+	ticket, err := svc.Tickets.PasswordChange(mgmt.PasswordChangeTicketOpts{
+		UserID:              "auth0|user1",
+		ResultURL:           "https://app.example.com/welcome",
+		TTLSeconds:          86400,
+		MarkEmailAsVerified: mgmt.Bool(true),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.auth0.com/lo/reset?ticket=abc#", ticket.URL)
+	assert.JSONEq(t, `{
+		"user_id": "auth0|user1",
+		"result_url": "https://app.example.com/welcome",
+		"ttl_sec": 86400,
+		"mark_email_as_verified": true
+	}`, string(req.Body))
+}
+
+func TestTicketsEmailVerification(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	req := expectRequest(t, mockDoer, gohttp.MethodPost, "/tickets/email-verification",
+		`{"ticket": "https://example.auth0.com/u/email-verification?ticket=abc#"}`)
+
+	_, err := svc.Tickets.EmailVerification(mgmt.EmailVerificationTicketOpts{
+		UserID:         "google-oauth2|1",
+		OrganizationID: "org_1",
+		Identity:       &mgmt.TicketIdentity{UserID: "1", Provider: "google-oauth2"},
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"user_id": "google-oauth2|1",
+		"organization_id": "org_1",
+		"identity": {"user_id": "1", "provider": "google-oauth2"}
+	}`, string(req.Body))
+
+	req = expectRequest(t, mockDoer, gohttp.MethodPost, "/jobs/verification-email",
+		`{"id": "job_1", "type": "verification_email", "status": "pending"}`)
+
+	job, err := svc.Jobs.SendVerificationEmail(mgmt.VerificationEmailOpts{UserID: "auth0|user1"})
+	require.NoError(t, err)
+	assert.Equal(t, "verification_email", job.Type)
+	assert.JSONEq(t, `{"user_id": "auth0|user1"}`, string(req.Body))
+}
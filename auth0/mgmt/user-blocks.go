@@ -0,0 +1,55 @@
+package mgmt
+
+import (
+	"net/url"
+
+	"github.com/zenoss/go-auth0/auth0/http"
+)
+
+// UserBlocksService provides a service for functions related to the blocks
+// set by brute-force protection
+type UserBlocksService struct {
+	c *http.Client
+}
+
+// UserBlock is a block of logins to an account from an IP address
+type UserBlock struct {
+	Identifier string `json:"identifier,omitempty"`
+	IP         string `json:"ip,omitempty"`
+	Connection string `json:"connection,omitempty"`
+}
+
+type userBlocksBody struct {
+	BlockedFor []UserBlock `json:"blocked_for"`
+}
+
+// Get returns the blocks of a user
+func (svc *UserBlocksService) Get(userID string) ([]UserBlock, error) {
+	var body userBlocksBody
+
+	err := svc.c.Get("/user-blocks/"+userID, &body)
+
+	return body.BlockedFor, err
+}
+
+// GetByIdentifier returns the blocks of the users with the given email,
+// username or phone number
+func (svc *UserBlocksService) GetByIdentifier(identifier string) ([]UserBlock, error) {
+	var body userBlocksBody
+
+	err := svc.c.Get("/user-blocks?identifier="+url.QueryEscape(identifier), &body)
+
+	return body.BlockedFor, err
+}
+
+// Unblock removes the blocks of a user. Blocks set with
+// UserUpdateOpts.Blocked are not removed.
+func (svc *UserBlocksService) Unblock(userID string) error {
+	return svc.c.Delete("/user-blocks/"+userID, nil, nil)
+}
+
+// UnblockByIdentifier removes the blocks of the users with the given email,
+// username or phone number
+func (svc *UserBlocksService) UnblockByIdentifier(identifier string) error {
+	return svc.c.Delete("/user-blocks?identifier="+url.QueryEscape(identifier), nil, nil)
+}
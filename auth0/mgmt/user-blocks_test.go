@@ -0,0 +1,27 @@
+package mgmt_test
+
+import (
+	gohttp "net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zenoss/go-auth0/auth0/mgmt"
+)
+
+func TestUserBlocks(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	req := expectRequest(t, mockDoer, gohttp.MethodGet, "/user-blocks",
+		`{"blocked_for": [{"identifier": "someone+tag@example.com", "ip": "10.0.0.1", "connection": "Username-Password-Authentication"}]}`)
+
+	blocks, err := svc.UserBlocks.GetByIdentifier("someone+tag@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, []mgmt.UserBlock{
+		{Identifier: "someone+tag@example.com", IP: "10.0.0.1", Connection: "Username-Password-Authentication"},
+	}, blocks)
+	assert.Equal(t, "someone+tag@example.com", req.Query.Get("identifier"))
+
+	expectRequest(t, mockDoer, gohttp.MethodDelete, "/user-blocks/auth0|user1", "")
+	require.NoError(t, svc.UserBlocks.Unblock("auth0|user1"))
+}
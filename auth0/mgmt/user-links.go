@@ -0,0 +1,98 @@
+package mgmt
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// ErrEmailNotVerified is returned when looking for the link candidates of a
+// user whose email is not verified. Linking on an unverified email would let
+// anyone claiming the address take over the account.
+var ErrEmailNotVerified = errors.New("go-auth0: email not verified")
+
+// LinkOpts identifies the secondary account to link to a primary user,
+// either by the ID token of the secondary account (LinkWith), or by its
+// provider and user ID.
+type LinkOpts struct {
+	LinkWith     string `json:"link_with,omitempty"`
+	Provider     string `json:"provider,omitempty"`
+	UserID       string `json:"user_id,omitempty"`
+	ConnectionID string `json:"connection_id,omitempty"`
+}
+
+// LinkWithIDToken returns the options to link the account an ID token was
+// issued for
+func LinkWithIDToken(idToken string) LinkOpts {
+	return LinkOpts{LinkWith: idToken}
+}
+
+// LinkUser returns the options to link the main identity of secondary
+func LinkUser(secondary User) LinkOpts {
+	if len(secondary.Identities) == 0 {
+		return LinkOpts{}
+	}
+
+	identity := secondary.Identities[0]
+
+	return LinkOpts{Provider: identity.Provider, UserID: identity.ID}
+}
+
+// Link links a secondary account to a primary user. The secondary user is
+// removed, and its identity added to the primary user's identities, which
+// are returned.
+func (svc *UsersService) Link(primaryID string, secondary LinkOpts) ([]Identity, error) {
+	var identities []Identity
+
+	err := svc.c.Post("/users/"+primaryID+"/identities", secondary, &identities)
+
+	return identities, err
+}
+
+// Unlink unlinks an identity from a primary user, making it a separate user
+// again. The remaining identities of the primary user are returned.
+func (svc *UsersService) Unlink(primaryID, provider, secondaryUserID string) ([]Identity, error) {
+	var identities []Identity
+
+	err := svc.c.Delete("/users/"+primaryID+"/identities/"+provider+"/"+secondaryUserID, nil, &identities)
+
+	return identities, err
+}
+
+// GetByEmail returns the users with the given email, across connections
+func (svc *UsersService) GetByEmail(email string) ([]User, error) {
+	var users []User
+
+	err := svc.c.Get("/users-by-email?email="+url.QueryEscape(email), &users)
+
+	return users, err
+}
+
+// FindLinkCandidates returns the other users, from any connection, which
+// have the same verified email as the user. The user's own email must be
+// verified, or ErrEmailNotVerified is returned.
+func (svc *UsersService) FindLinkCandidates(userID string) ([]User, error) {
+	user, err := svc.Get(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Email == "" || !user.EmailVerified {
+		return nil, fmt.Errorf("%w: %s", ErrEmailNotVerified, userID)
+	}
+
+	users, err := svc.GetByEmail(user.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []User
+
+	for _, u := range users {
+		if u.ID != user.ID && u.EmailVerified {
+			candidates = append(candidates, u)
+		}
+	}
+
+	return candidates, nil
+}
@@ -0,0 +1,65 @@
+package mgmt_test
+
+import (
+	gohttp "net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zenoss/go-auth0/auth0/mgmt"
+)
+
+func TestUsersLink(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	req := expectRequest(t, mockDoer, gohttp.MethodPost, "/users/auth0|user1/identities", `[
+		{"connection": "Username-Password-Authentication", "user_id": "user1", "provider": "auth0"},
+		{"connection": "google-oauth2", "user_id": "123", "provider": "google-oauth2", "isSocial": true}
+	]`)
+
+	secondary := mgmt.User{
+		ID:         "google-oauth2|123",
+		Identities: []mgmt.Identity{{Connection: "google-oauth2", ID: "123", Provider: "google-oauth2"}},
+	}
+
+	identities, err := svc.Users.Link("auth0|user1", mgmt.LinkUser(secondary))
+	require.NoError(t, err)
+	assert.Len(t, identities, 2)
+	assert.JSONEq(t, `{"provider": "google-oauth2", "user_id": "123"}`, string(req.Body))
+
+	req = expectRequest(t, mockDoer, gohttp.MethodPost, "/users/auth0|user1/identities", `[]`)
+	_, err = svc.Users.Link("auth0|user1", mgmt.LinkWithIDToken("eyJ..."))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"link_with": "eyJ..."}`, string(req.Body))
+
+	expectRequest(t, mockDoer, gohttp.MethodDelete, "/users/auth0|user1/identities/google-oauth2/123",
+		`[{"connection": "Username-Password-Authentication", "user_id": "user1", "provider": "auth0"}]`)
+
+	identities, err = svc.Users.Unlink("auth0|user1", "google-oauth2", "123")
+	require.NoError(t, err)
+	assert.Len(t, identities, 1)
+}
+
+func TestUsersFindLinkCandidates(t *testing.T) {
+	svc, mockDoer := newMockedManagement(t)
+
+	expectRequest(t, mockDoer, gohttp.MethodGet, "/users/auth0|user1",
+		`{"user_id": "auth0|user1", "email": "Someone@example.com", "email_verified": true}`)
+	req := expectRequest(t, mockDoer, gohttp.MethodGet, "/users-by-email", `[
+		{"user_id": "auth0|user1", "email": "someone@example.com", "email_verified": true},
+		{"user_id": "google-oauth2|123", "email": "someone@example.com", "email_verified": true},
+		{"user_id": "samlp|acme|someone", "email": "someone@example.com"}
+	]`)
+
+	candidates, err := svc.Users.FindLinkCandidates("auth0|user1")
+	require.NoError(t, err)
+	require.Len(t, candidates, 1)
+	assert.Equal(t, "google-oauth2|123", candidates[0].ID)
+	assert.Equal(t, "Someone@example.com", req.Query.Get("email"))
+
+	expectRequest(t, mockDoer, gohttp.MethodGet, "/users/auth0|user2",
+		`{"user_id": "auth0|user2", "email": "other@example.com"}`)
+
+	_, err = svc.Users.FindLinkCandidates("auth0|user2")
+	assert.ErrorIs(t, err, mgmt.ErrEmailNotVerified)
+}
@@ -180,3 +180,50 @@ func (svc *UsersService) Update(userID string, opts UserUpdateOpts) (User, error
 
 	return user, err
 }
+
+// GetRoles returns all roles assigned to a user
+func (svc *UsersService) GetRoles(userID string) ([]Role, error) {
+	var roles []Role
+
+	err := svc.c.GetV2("/users/"+userID+"/roles", &roles)
+
+	return roles, err
+}
+
+// AssignRoles assigns roles to a user
+func (svc *UsersService) AssignRoles(userID string, roleIDs []string) error {
+	body := rolesBody{Roles: roleIDs}
+
+	return svc.c.Post("/users/"+userID+"/roles", &body, nil)
+}
+
+// RemoveRoles removes roles from a user
+func (svc *UsersService) RemoveRoles(userID string, roleIDs []string) error {
+	body := rolesBody{Roles: roleIDs}
+
+	return svc.c.Delete("/users/"+userID+"/roles", &body, nil)
+}
+
+// GetPermissions returns all permissions of a user, both those granted
+// directly and those granted through roles
+func (svc *UsersService) GetPermissions(userID string) ([]Permission, error) {
+	var permissions []Permission
+
+	err := svc.c.GetV2("/users/"+userID+"/permissions", &permissions)
+
+	return permissions, err
+}
+
+// AddPermissions grants permissions directly to a user
+func (svc *UsersService) AddPermissions(userID string, permissions []Permission) error {
+	body := newPermissionsBody(permissions)
+
+	return svc.c.Post("/users/"+userID+"/permissions", &body, nil)
+}
+
+// RemovePermissions removes permissions granted directly to a user
+func (svc *UsersService) RemovePermissions(userID string, permissions []Permission) error {
+	body := newPermissionsBody(permissions)
+
+	return svc.c.Delete("/users/"+userID+"/permissions", &body, nil)
+}
@@ -0,0 +1,186 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/zenoss/go-auth0/auth0/verify"
+)
+
+var (
+	// ErrMissingToken is returned when a request has no bearer token
+	ErrMissingToken = errors.New("go-auth0: missing bearer token")
+	// ErrForbidden is returned when a principal lacks a required role or permission
+	ErrForbidden = errors.New("go-auth0: insufficient roles or permissions")
+	// ErrResolveAccess is returned when the subject's access cannot be resolved
+	ErrResolveAccess = errors.New("go-auth0: cannot resolve roles and permissions")
+)
+
+// TokenVerifier validates bearer tokens. *verify.Validator implements it.
+type TokenVerifier interface {
+	VerifyAccessToken(token string) (*verify.AccessTokenClaims, error)
+}
+
+// Principal is the authenticated caller of a request
+type Principal struct {
+	Subject     string
+	Claims      *verify.AccessTokenClaims
+	Roles       []string
+	Permissions []string
+}
+
+// HasPermission reports whether the principal was granted permission
+func (p *Principal) HasPermission(permission string) bool {
+	return slices.Contains(p.Permissions, permission)
+}
+
+// HasRole reports whether the principal holds role
+func (p *Principal) HasRole(role string) bool {
+	return slices.Contains(p.Roles, role)
+}
+
+type contextKey struct{}
+
+// WithPrincipal returns a copy of ctx carrying p
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, contextKey{}, p)
+}
+
+// PrincipalFromContext returns the principal put on ctx by Authenticate
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(contextKey{}).(*Principal)
+	return p, ok
+}
+
+// ErrorHandler writes the response for a request that failed authentication
+// or authorization
+type ErrorHandler func(w http.ResponseWriter, r *http.Request, err error)
+
+// DefaultErrorHandler responds 403 for ErrForbidden, 500 for ErrResolveAccess
+// and 401 otherwise, without leaking error details to the caller
+func DefaultErrorHandler(w http.ResponseWriter, _ *http.Request, err error) {
+	switch {
+	case errors.Is(err, ErrForbidden):
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	case errors.Is(err, ErrResolveAccess):
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+	http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+}
+
+// Middleware authenticates requests with a bearer token and authorizes them
+// using the roles and permissions of the token's subject
+type Middleware struct {
+	verifier TokenVerifier
+	resolver Resolver
+
+	// OnError handles failed requests. Defaults to DefaultErrorHandler.
+	OnError ErrorHandler
+}
+
+// New creates a Middleware that validates tokens with verifier and resolves
+// access with resolver
+func New(verifier TokenVerifier, resolver Resolver) *Middleware {
+	return &Middleware{
+		verifier: verifier,
+		resolver: resolver,
+		OnError:  DefaultErrorHandler,
+	}
+}
+
+// Authenticate validates the bearer token, resolves the subject's roles and
+// permissions, and puts the resulting Principal on the request context
+func (m *Middleware) Authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		principal, err := m.principal(r)
+		if err != nil {
+			m.OnError(w, r, err)
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(WithPrincipal(r.Context(), principal)))
+	})
+}
+
+// RequirePermission authenticates the request and requires all of permissions
+func (m *Middleware) RequirePermission(permissions ...string) func(http.Handler) http.Handler {
+	return m.require(func(p *Principal) bool {
+		return !slices.ContainsFunc(permissions, func(perm string) bool { return !p.HasPermission(perm) })
+	})
+}
+
+// RequireRole authenticates the request and requires any one of roles
+func (m *Middleware) RequireRole(roles ...string) func(http.Handler) http.Handler {
+	return m.require(func(p *Principal) bool {
+		return slices.ContainsFunc(roles, p.HasRole)
+	})
+}
+
+func (m *Middleware) require(allowed func(*Principal) bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			principal, ok := PrincipalFromContext(r.Context())
+			if !ok {
+				var err error
+
+				principal, err = m.principal(r)
+				if err != nil {
+					m.OnError(w, r, err)
+					return
+				}
+
+				r = r.WithContext(WithPrincipal(r.Context(), principal))
+			}
+
+			if !allowed(principal) {
+				m.OnError(w, r, ErrForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func (m *Middleware) principal(r *http.Request) (*Principal, error) {
+	token, ok := bearerToken(r)
+	if !ok {
+		return nil, ErrMissingToken
+	}
+
+	claims, err := m.verifier.VerifyAccessToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	access, err := m.resolver.Resolve(claims.Subject)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrResolveAccess, err)
+	}
+
+	return &Principal{
+		Subject:     claims.Subject,
+		Claims:      claims,
+		Roles:       access.Roles,
+		Permissions: access.Permissions,
+	}, nil
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+
+	token = strings.TrimSpace(token)
+
+	return token, token != ""
+}
@@ -0,0 +1,229 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"errors"
+	gohttp "net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/zenoss/go-auth0/auth0/authz"
+	"github.com/zenoss/go-auth0/auth0/http"
+	mocks "github.com/zenoss/go-auth0/auth0/http/mocks"
+	"github.com/zenoss/go-auth0/auth0/middleware"
+	"github.com/zenoss/go-auth0/auth0/verify"
+)
+
+type fakeVerifier struct{}
+
+func (fakeVerifier) VerifyAccessToken(token string) (*verify.AccessTokenClaims, error) {
+	if token != "good" {
+		return nil, verify.ErrInvalidSignature
+	}
+
+	return &verify.AccessTokenClaims{
+		RegisteredClaims: verify.RegisteredClaims{Subject: "auth0|user1"},
+	}, nil
+}
+
+type fakeResolver struct {
+	access middleware.Access
+	err    error
+}
+
+func (r fakeResolver) Resolve(_ string) (middleware.Access, error) {
+	return r.access, r.err
+}
+
+func serve(t *testing.T, h gohttp.Handler, token string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(gohttp.MethodGet, "/devices", gohttp.NoBody)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	t.Logf("%d %s", rec.Code, rec.Body.String())
+
+	return rec
+}
+
+func TestMiddleware(t *testing.T) {
+	m := middleware.New(fakeVerifier{}, fakeResolver{access: middleware.Access{
+		Roles:       []string{"viewer"},
+		Permissions: []string{"read:devices"},
+	}})
+
+	var principal *middleware.Principal
+
+	ok := gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		principal, _ = middleware.PrincipalFromContext(r.Context())
+		w.WriteHeader(gohttp.StatusNoContent)
+	})
+
+	rec := serve(t, m.RequirePermission("read:devices")(ok), "good")
+	assert.Equal(t, gohttp.StatusNoContent, rec.Code)
+	require.NotNil(t, principal)
+	assert.Equal(t, "auth0|user1", principal.Subject)
+
+	rec = serve(t, m.RequirePermission("read:devices", "write:devices")(ok), "good")
+	assert.Equal(t, gohttp.StatusForbidden, rec.Code)
+
+	rec = serve(t, m.RequireRole("admin", "viewer")(ok), "good")
+	assert.Equal(t, gohttp.StatusNoContent, rec.Code)
+
+	rec = serve(t, m.RequireRole("admin")(ok), "good")
+	assert.Equal(t, gohttp.StatusForbidden, rec.Code)
+
+	rec = serve(t, m.Authenticate(m.RequireRole("viewer")(ok)), "good")
+	assert.Equal(t, gohttp.StatusNoContent, rec.Code)
+
+	rec = serve(t, m.Authenticate(ok), "bad")
+	assert.Equal(t, gohttp.StatusUnauthorized, rec.Code)
+	assert.NotEmpty(t, rec.Header().Get("WWW-Authenticate"))
+
+	rec = serve(t, m.Authenticate(ok), "")
+	assert.Equal(t, gohttp.StatusUnauthorized, rec.Code)
+
+	failing := middleware.New(fakeVerifier{}, fakeResolver{err: errors.New("authz is down")})
+	rec = serve(t, failing.Authenticate(ok), "good")
+	assert.Equal(t, gohttp.StatusInternalServerError, rec.Code)
+}
+
+func TestAuthzResolver(t *testing.T) {
+	mockDoer := &mocks.Doer{}
+	svc := authz.New(&http.Client{Doer: mockDoer})
+
+	respond := func(path, body string) {
+		mockDoer.On("Do", mock.MatchedBy(func(req *gohttp.Request) bool {
+			return req.URL.Path == path
+		}), mock.Anything).
+			Return(nil).
+			Run(func(args mock.Arguments) {
+				err := json.Unmarshal([]byte(body), args.Get(1))
+				assert.NoError(t, err)
+			}).Once()
+	}
+
+	respond("/users/auth0|user1/roles/calculate", `[
+		{"_id": "r1", "name": "viewer", "applicationId": "app1", "permissions": ["p1"]},
+		{"_id": "r2", "name": "editor", "applicationId": "app1", "permissions": ["p1", "p2"]},
+		{"_id": "r3", "name": "other", "applicationId": "app2", "permissions": ["p3"]}
+	]`)
+	respond("/permissions", `{"permissions": [
+		{"_id": "p1", "name": "read:devices"},
+		{"_id": "p2", "name": "write:devices"},
+		{"_id": "p3", "name": "read:billing"}
+	]}`)
+
+	resolver := middleware.NewAuthzResolver(svc, 0)
+	resolver.ApplicationID = "app1"
+
+	access, err := resolver.Resolve("auth0|user1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"viewer", "editor"}, access.Roles)
+	assert.Equal(t, []string{"read:devices", "write:devices"}, access.Permissions)
+
+	// Cached: the mock only answers each request once
+	access, err = resolver.Resolve("auth0|user1")
+	require.NoError(t, err)
+	assert.Len(t, access.Permissions, 2)
+	mockDoer.AssertExpectations(t)
+}
+
+func TestAuthzResolverNewPermission(t *testing.T) {
+	mockDoer := &mocks.Doer{}
+	svc := authz.New(&http.Client{Doer: mockDoer})
+
+	respond := func(path, body string) {
+		mockDoer.On("Do", mock.MatchedBy(func(req *gohttp.Request) bool {
+			return req.URL.Path == path
+		}), mock.Anything).
+			Return(nil).
+			Run(func(args mock.Arguments) {
+				err := json.Unmarshal([]byte(body), args.Get(1))
+				assert.NoError(t, err)
+			}).Once()
+	}
+
+	respond("/users/auth0|user1/roles/calculate", `[{"_id": "r1", "name": "viewer", "permissions": ["p1"]}]`)
+	respond("/permissions", `{"permissions": [{"_id": "p1", "name": "read:devices"}]}`)
+
+	resolver := middleware.NewAuthzResolver(svc, time.Hour)
+
+	_, err := resolver.Resolve("auth0|user1")
+	require.NoError(t, err)
+
+	// p2 is created after the permissions were loaded, so they are reloaded
+	respond("/users/auth0|user2/roles/calculate", `[{"_id": "r2", "name": "editor", "permissions": ["p1", "p2"]}]`)
+	respond("/permissions", `{"permissions": [
+		{"_id": "p1", "name": "read:devices"},
+		{"_id": "p2", "name": "write:devices"}
+	]}`)
+
+	access, err := resolver.Resolve("auth0|user2")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"read:devices", "write:devices"}, access.Permissions)
+	mockDoer.AssertExpectations(t)
+}
+
+func TestAuthzResolverConcurrentMisses(t *testing.T) {
+	mockDoer := &mocks.Doer{}
+	svc := authz.New(&http.Client{Doer: mockDoer})
+
+	respond := func(path, body string) {
+		mockDoer.On("Do", mock.MatchedBy(func(req *gohttp.Request) bool {
+			return req.URL.Path == path
+		}), mock.Anything).
+			Return(nil).
+			After(20 * time.Millisecond).
+			Run(func(args mock.Arguments) {
+				err := json.Unmarshal([]byte(body), args.Get(1))
+				assert.NoError(t, err)
+			}).Once()
+	}
+
+	// Each request is answered once, so concurrent misses must share it
+	respond("/users/auth0|user1/roles/calculate", `[{"_id": "r1", "name": "viewer", "permissions": ["p1"]}]`)
+	respond("/permissions", `{"permissions": [{"_id": "p1", "name": "read:devices"}]}`)
+
+	resolver := middleware.NewAuthzResolver(svc, time.Hour)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			access, err := resolver.Resolve("auth0|user1")
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"read:devices"}, access.Permissions)
+		}()
+	}
+
+	wg.Wait()
+	mockDoer.AssertExpectations(t)
+
+	// Once expired, the subject is looked up again
+	resolver = middleware.NewAuthzResolver(svc, time.Millisecond)
+	respond("/users/auth0|user1/roles/calculate", `[]`)
+	respond("/permissions", `{"permissions": []}`)
+	respond("/users/auth0|user1/roles/calculate", `[{"_id": "r1", "name": "viewer"}]`)
+	respond("/permissions", `{"permissions": []}`)
+
+	_, err := resolver.Resolve("auth0|user1")
+	require.NoError(t, err)
+
+	time.Sleep(5 * time.Millisecond)
+
+	access, err := resolver.Resolve("auth0|user1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"viewer"}, access.Roles)
+	mockDoer.AssertExpectations(t)
+}
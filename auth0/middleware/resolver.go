@@ -0,0 +1,232 @@
+package middleware
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/zenoss/go-auth0/auth0/authz"
+)
+
+// DefaultCacheTTL is how long resolved roles and permissions are cached
+const DefaultCacheTTL = 5 * time.Minute
+
+// Access is the set of roles and permissions granted to a subject
+type Access struct {
+	Roles       []string
+	Permissions []string
+}
+
+// Resolver resolves the effective roles and permissions of a subject
+type Resolver interface {
+	Resolve(subject string) (Access, error)
+}
+
+type cachedAccess struct {
+	access  Access
+	expires time.Time
+}
+
+// AuthzResolver resolves access through the Authorization Extension, including
+// roles inherited through group membership. Results are cached per subject;
+// expired entries are swept once per ttl, and concurrent misses for the same
+// subject share a single lookup.
+type AuthzResolver struct {
+	svc *authz.AuthorizationService
+	ttl time.Duration
+
+	// ApplicationID, if set, only considers roles for that application
+	ApplicationID string
+
+	mu          sync.Mutex
+	subjects    map[string]cachedAccess
+	swept       time.Time
+	permissions map[string]string
+	permsExpire time.Time
+
+	group singleflight.Group
+}
+
+// NewAuthzResolver creates an AuthzResolver backed by svc. A ttl of zero uses
+// DefaultCacheTTL.
+func NewAuthzResolver(svc *authz.AuthorizationService, ttl time.Duration) *AuthzResolver {
+	if ttl <= 0 {
+		ttl = DefaultCacheTTL
+	}
+
+	return &AuthzResolver{
+		svc:      svc,
+		ttl:      ttl,
+		subjects: map[string]cachedAccess{},
+	}
+}
+
+// Resolve returns the roles and permission names granted to subject
+func (r *AuthzResolver) Resolve(subject string) (Access, error) {
+	if access, ok := r.cached(subject); ok {
+		return access, nil
+	}
+
+	access, err, _ := r.group.Do("subject:"+subject, func() (any, error) {
+		return r.resolve(subject)
+	})
+	if err != nil {
+		return Access{}, err
+	}
+
+	return access.(Access), nil
+}
+
+// cached returns the unexpired access of subject, dropping it if expired
+func (r *AuthzResolver) cached(subject string) (Access, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cached, ok := r.subjects[subject]
+	if !ok {
+		return Access{}, false
+	}
+
+	if time.Now().Before(cached.expires) {
+		return cached.access, true
+	}
+
+	delete(r.subjects, subject)
+
+	return Access{}, false
+}
+
+// store caches the access of subject, sweeping expired entries at most once per ttl
+func (r *AuthzResolver) store(subject string, access Access) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(r.swept) >= r.ttl {
+		for other, cached := range r.subjects {
+			if !now.Before(cached.expires) {
+				delete(r.subjects, other)
+			}
+		}
+
+		r.swept = now
+	}
+
+	r.subjects[subject] = cachedAccess{access: access, expires: now.Add(r.ttl)}
+}
+
+// resolve looks up the access of subject and caches it
+func (r *AuthzResolver) resolve(subject string) (Access, error) {
+	roles, err := r.svc.Users.GetAllRoles(subject)
+	if err != nil {
+		return Access{}, err
+	}
+
+	roles = slices.DeleteFunc(roles, func(role authz.Role) bool {
+		return r.ApplicationID != "" && role.ApplicationID != r.ApplicationID
+	})
+
+	names, err := r.permissionNames()
+	if err != nil {
+		return Access{}, err
+	}
+
+	// A role may reference a permission created since the names were loaded
+	if !knowsPermissions(names, roles) {
+		r.mu.Lock()
+		r.permissions = nil
+		r.mu.Unlock()
+
+		if names, err = r.permissionNames(); err != nil {
+			return Access{}, err
+		}
+	}
+
+	var access Access
+
+	seen := map[string]bool{}
+
+	for _, role := range roles {
+		access.Roles = append(access.Roles, role.Name)
+
+		for _, id := range role.PermissionIDs {
+			name, ok := names[id]
+			if !ok || seen[name] {
+				continue
+			}
+
+			seen[name] = true
+			access.Permissions = append(access.Permissions, name)
+		}
+	}
+
+	r.store(subject, access)
+
+	return access, nil
+}
+
+// knowsPermissions reports whether names has every permission of roles
+func knowsPermissions(names map[string]string, roles []authz.Role) bool {
+	for _, role := range roles {
+		for _, id := range role.PermissionIDs {
+			if _, ok := names[id]; !ok {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Invalidate drops the cached access for subject, or for everyone if subject is empty
+func (r *AuthzResolver) Invalidate(subject string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if subject == "" {
+		r.subjects = map[string]cachedAccess{}
+		r.permissions = nil
+
+		return
+	}
+
+	delete(r.subjects, subject)
+}
+
+// permissionNames maps permission IDs to names, since roles only reference IDs
+func (r *AuthzResolver) permissionNames() (map[string]string, error) {
+	r.mu.Lock()
+	names, expires := r.permissions, r.permsExpire
+	r.mu.Unlock()
+
+	if names != nil && time.Now().Before(expires) {
+		return names, nil
+	}
+
+	loaded, err, _ := r.group.Do("permissions", func() (any, error) {
+		perms, err := r.svc.Permissions.GetAll()
+		if err != nil {
+			return nil, fmt.Errorf("go-auth0: cannot get permissions: %w", err)
+		}
+
+		names := make(map[string]string, len(perms))
+		for _, perm := range perms {
+			names[perm.ID] = perm.Name
+		}
+
+		r.mu.Lock()
+		r.permissions = names
+		r.permsExpire = time.Now().Add(r.ttl)
+		r.mu.Unlock()
+
+		return names, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return loaded.(map[string]string), nil
+}
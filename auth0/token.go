@@ -1,7 +1,11 @@
 package auth0
 
 import (
+	"errors"
 	"fmt"
+	"time"
+
+	"golang.org/x/oauth2"
 
 	"github.com/zenoss/go-auth0/auth0/http"
 )
@@ -11,6 +15,18 @@ type TokenService struct {
 	*http.Client
 }
 
+// ErrInvalidRefreshToken is returned when Auth0 rejects a refresh token
+// because it is expired, revoked, or was already used. With refresh token
+// rotation, reusing a rotated token also revokes every token in its family,
+// so callers should treat this error as a logout.
+var ErrInvalidRefreshToken = errors.New("go-auth0: refresh token is invalid, expired, revoked or reused")
+
+func isInvalidGrant(err error) bool {
+	httpErr, ok := http.AsError(err)
+
+	return ok && httpErr.HTTPError == "invalid_grant"
+}
+
 // TokenResponseBody contains token related information returned
 // from a token request to Auth0
 type TokenResponseBody struct {
@@ -20,6 +36,90 @@ type TokenResponseBody struct {
 	TokenType    string `json:"token_type,omitempty"`
 	ExpiresIn    uint32 `json:"expires_in,omitempty"`
 	Scope        string `json:"scope,omitempty"`
+	// ObtainedAt is when the token was requested, stamped by TokenService.
+	// It is kept when the body is serialized so cached tokens can be checked.
+	ObtainedAt time.Time `json:"obtained_at,omitzero"`
+}
+
+// Expiry returns when the access token expires, or the zero time if the
+// token does not expire. A token which expires but whose issuance time is
+// unknown, e.g. one cached without ObtainedAt, is treated as already expired.
+func (body *TokenResponseBody) Expiry() time.Time {
+	if body.ExpiresIn == 0 {
+		return time.Time{}
+	}
+
+	if body.ObtainedAt.IsZero() {
+		return time.Unix(0, 0)
+	}
+
+	return body.ObtainedAt.Add(time.Duration(body.ExpiresIn) * time.Second)
+}
+
+// Valid reports whether the body holds an access token that will still
+// be unexpired after leeway
+func (body *TokenResponseBody) Valid(leeway time.Duration) bool {
+	if body == nil || body.AccessToken == "" {
+		return false
+	}
+
+	expiry := body.Expiry()
+
+	return expiry.IsZero() || time.Now().Add(leeway).Before(expiry)
+}
+
+// OAuth2Token converts the body to an *oauth2.Token. The ID token and scope
+// are available through the token's Extra method.
+func (body *TokenResponseBody) OAuth2Token() *oauth2.Token {
+	token := &oauth2.Token{
+		AccessToken:  body.AccessToken,
+		TokenType:    body.TokenType,
+		RefreshToken: body.RefreshToken,
+		Expiry:       body.Expiry(),
+		ExpiresIn:    int64(body.ExpiresIn),
+	}
+
+	extra := map[string]any{}
+	if body.IDToken != "" {
+		extra["id_token"] = body.IDToken
+	}
+
+	if body.Scope != "" {
+		extra["scope"] = body.Scope
+	}
+
+	return token.WithExtra(extra)
+}
+
+// TokenResponseBodyFromOAuth2 converts an *oauth2.Token to a TokenResponseBody
+func TokenResponseBodyFromOAuth2(token *oauth2.Token) *TokenResponseBody {
+	body := &TokenResponseBody{
+		AccessToken:  token.AccessToken,
+		TokenType:    token.TokenType,
+		RefreshToken: token.RefreshToken,
+	}
+
+	if idToken, ok := token.Extra("id_token").(string); ok {
+		body.IDToken = idToken
+	}
+
+	if scope, ok := token.Extra("scope").(string); ok {
+		body.Scope = scope
+	}
+
+	if !token.Expiry.IsZero() {
+		body.ObtainedAt = time.Now()
+		body.ExpiresIn = 1
+
+		if remaining := time.Until(token.Expiry); remaining >= time.Second {
+			body.ExpiresIn = uint32(remaining / time.Second)
+		} else {
+			// Keep an expired token expired rather than never expiring
+			body.ObtainedAt = token.Expiry.Add(-time.Second)
+		}
+	}
+
+	return body
 }
 
 // TokenRequestBody contains fields that may be used as data in a POST
@@ -52,14 +152,98 @@ func (svc *TokenService) GetToken(body TokenRequestBody) (*TokenResponseBody, er
 		"User-Agent": body.Device,
 	}
 
+	// Stamp the time before the request so the expiry errs on the early side
+	obtainedAt := time.Now()
+
 	err := svc.PostWithHeaders("/oauth/token", body, &resBody, headers)
 	if err != nil {
+		if body.GrantType == "refresh_token" && isInvalidGrant(err) {
+			return nil, fmt.Errorf("Cannot complete token request: %w: %w", ErrInvalidRefreshToken, err)
+		}
+
 		return nil, fmt.Errorf("Cannot complete token request: %w", err)
 	}
 
+	resBody.ObtainedAt = obtainedAt
+
+	// Without refresh token rotation Auth0 does not return the refresh token
+	// again; carry it forward so callers always have the one to use next.
+	if body.GrantType == "refresh_token" && resBody.RefreshToken == "" {
+		resBody.RefreshToken = body.RefreshToken
+	}
+
 	return &resBody, nil
 }
 
+// GetTokenFromRefreshToken gets a new access token using a refresh token.
+// When refresh token rotation is enabled the response holds a new refresh
+// token that replaces the one used; otherwise it holds the one used. An
+// ErrInvalidRefreshToken error means the user has to log in again.
+func (svc *TokenService) GetTokenFromRefreshToken(refreshToken, clientID, clientSecret string) (*TokenResponseBody, error) {
+	body := TokenRequestBody{
+		GrantType:    "refresh_token",
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		RefreshToken: refreshToken,
+	}
+
+	return svc.GetToken(body)
+}
+
+// RevokeRefreshToken revokes a refresh token through /oauth/revoke, e.g. on
+// logout. The client secret is only required for confidential clients.
+func (svc *TokenService) RevokeRefreshToken(refreshToken, clientID, clientSecret string) error {
+	body := struct {
+		ClientID     string `json:"client_id,omitempty"`
+		ClientSecret string `json:"client_secret,omitempty"`
+		Token        string `json:"token"`
+	}{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Token:        refreshToken,
+	}
+
+	err := svc.Post("/oauth/revoke", body, nil)
+	if err != nil {
+		return fmt.Errorf("Cannot revoke refresh token: %w", err)
+	}
+
+	return nil
+}
+
+// TokenSource returns an oauth2.TokenSource that gets tokens with the grant
+// in body and renews them when they expire. If a response includes a refresh
+// token, later renewals use the refresh token grant, so single-use grants such
+// as authorization codes keep working.
+func (svc *TokenService) TokenSource(body TokenRequestBody) oauth2.TokenSource {
+	return oauth2.ReuseTokenSource(nil, &grantTokenSource{svc: svc, body: body})
+}
+
+type grantTokenSource struct {
+	svc  *TokenService
+	body TokenRequestBody
+}
+
+// Token gets a new token; oauth2.ReuseTokenSource serializes calls to it
+func (src *grantTokenSource) Token() (*oauth2.Token, error) {
+	resBody, err := src.svc.GetToken(src.body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resBody.RefreshToken != "" {
+		src.body = TokenRequestBody{
+			GrantType:    "refresh_token",
+			ClientID:     src.body.ClientID,
+			ClientSecret: src.body.ClientSecret,
+			RefreshToken: resBody.RefreshToken,
+			Device:       src.body.Device,
+		}
+	}
+
+	return resBody.OAuth2Token(), nil
+}
+
 // GetTokenFromClientCreds gets an access token to the target API
 // using client credientials to authenticate
 func (svc *TokenService) GetTokenFromClientCreds(clientID, clientSecret, audience string) (*TokenResponseBody, error) {
@@ -0,0 +1,141 @@
+package auth0_test
+
+import (
+	"encoding/json"
+	gohttp "net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zenoss/go-auth0/auth0"
+)
+
+func TestTokenResponseBodyExpiry(t *testing.T) {
+	body := &auth0.TokenResponseBody{AccessToken: "abc", ExpiresIn: 3600}
+	assert.False(t, body.Valid(0), "expired without an obtained time")
+	assert.True(t, body.Expiry().Before(time.Now()))
+	assert.False(t, body.OAuth2Token().Valid())
+
+	body.ExpiresIn = 0
+	assert.True(t, body.Expiry().IsZero(), "no expiry without expires_in")
+	assert.True(t, body.Valid(time.Minute))
+
+	body.ExpiresIn = 3600
+
+	body.ObtainedAt = time.Now().Add(-59 * time.Minute)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), body.Expiry(), time.Second)
+	assert.True(t, body.Valid(0))
+	assert.False(t, body.Valid(2*time.Minute))
+
+	var nilBody *auth0.TokenResponseBody
+	assert.False(t, nilBody.Valid(0))
+}
+
+func TestTokenResponseBodyOAuth2(t *testing.T) {
+	body := &auth0.TokenResponseBody{
+		AccessToken:  "abc",
+		RefreshToken: "refresh",
+		IDToken:      "id",
+		TokenType:    "Bearer",
+		ExpiresIn:    3600,
+		Scope:        "openid offline_access",
+		ObtainedAt:   time.Now(),
+	}
+
+	token := body.OAuth2Token()
+	assert.Equal(t, "abc", token.AccessToken)
+	assert.Equal(t, "refresh", token.RefreshToken)
+	assert.Equal(t, "id", token.Extra("id_token"))
+	assert.Equal(t, body.Expiry(), token.Expiry)
+	assert.True(t, token.Valid())
+
+	back := auth0.TokenResponseBodyFromOAuth2(token)
+	assert.Equal(t, body.IDToken, back.IDToken)
+	assert.Equal(t, body.Scope, back.Scope)
+	assert.WithinDuration(t, body.Expiry(), back.Expiry(), 2*time.Second)
+
+	token.Expiry = time.Now().Add(-time.Minute)
+	assert.False(t, auth0.TokenResponseBodyFromOAuth2(token).Valid(0))
+}
+
+func TestTokenSource(t *testing.T) {
+	var grants []string
+
+	srv := httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		var body auth0.TokenRequestBody
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		grants = append(grants, body.GrantType)
+
+		// Expire immediately so every call renews
+		_ = json.NewEncoder(w).Encode(auth0.TokenResponseBody{
+			AccessToken:  "token" + body.GrantType,
+			RefreshToken: "refresh",
+			ExpiresIn:    1,
+		})
+	}))
+	defer srv.Close()
+
+	svc := auth0.TokenClient(srv.URL)
+	src := svc.TokenSource(auth0.TokenRequestBody{
+		GrantType: "authorization_code",
+		ClientID:  "client",
+		Code:      "code",
+	})
+
+	token, err := src.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "tokenauthorization_code", token.AccessToken)
+
+	token, err = src.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "tokenrefresh_token", token.AccessToken)
+	assert.Equal(t, []string{"authorization_code", "refresh_token"}, grants)
+}
+
+func TestRefreshAndRevoke(t *testing.T) {
+	revoked := ""
+
+	mux := gohttp.NewServeMux()
+	mux.HandleFunc("/oauth/token", func(w gohttp.ResponseWriter, r *gohttp.Request) {
+		var body auth0.TokenRequestBody
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "refresh_token", body.GrantType)
+
+		switch body.RefreshToken {
+		case "rotating":
+			_ = json.NewEncoder(w).Encode(auth0.TokenResponseBody{AccessToken: "a1", RefreshToken: "rotated"})
+		case "static":
+			_ = json.NewEncoder(w).Encode(auth0.TokenResponseBody{AccessToken: "a2"})
+		default:
+			w.WriteHeader(gohttp.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant","error_description":"Unknown or invalid refresh token."}`))
+		}
+	})
+	mux.HandleFunc("/oauth/revoke", func(_ gohttp.ResponseWriter, r *gohttp.Request) {
+		var body map[string]string
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		revoked = body["token"]
+	})
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	svc := auth0.TokenClient(srv.URL)
+
+	token, err := svc.GetTokenFromRefreshToken("rotating", "client", "")
+	require.NoError(t, err)
+	assert.Equal(t, "rotated", token.RefreshToken)
+
+	token, err = svc.GetTokenFromRefreshToken("static", "client", "")
+	require.NoError(t, err)
+	assert.Equal(t, "static", token.RefreshToken)
+
+	_, err = svc.GetTokenFromRefreshToken("reused", "client", "")
+	require.ErrorIs(t, err, auth0.ErrInvalidRefreshToken)
+	assert.Contains(t, err.Error(), "Unknown or invalid refresh token.")
+
+	require.NoError(t, svc.RevokeRefreshToken("rotated", "client", ""))
+	assert.Equal(t, "rotated", revoked)
+}
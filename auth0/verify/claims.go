@@ -0,0 +1,162 @@
+package verify
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Audience is the aud claim, which Auth0 sends as either a string or an array
+type Audience []string
+
+// UnmarshalJSON accepts both the string and array forms of aud
+func (a *Audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = Audience{single}
+		return nil
+	}
+
+	var multiple []string
+	if err := json.Unmarshal(data, &multiple); err != nil {
+		return err
+	}
+
+	*a = multiple
+
+	return nil
+}
+
+// Contains reports whether aud is one of the audiences
+func (a Audience) Contains(aud string) bool {
+	return slices.Contains(a, aud)
+}
+
+// RegisteredClaims are the registered JWT claims shared by access and ID tokens
+type RegisteredClaims struct {
+	Issuer          string   `json:"iss,omitempty"`
+	Subject         string   `json:"sub,omitempty"`
+	Audience        Audience `json:"aud,omitempty"`
+	ExpiresAt       int64    `json:"exp,omitempty"`
+	NotBefore       int64    `json:"nbf,omitempty"`
+	IssuedAt        int64    `json:"iat,omitempty"`
+	ID              string   `json:"jti,omitempty"`
+	AuthorizedParty string   `json:"azp,omitempty"`
+}
+
+// Expiry returns the exp claim as a time
+func (c RegisteredClaims) Expiry() time.Time {
+	return time.Unix(c.ExpiresAt, 0)
+}
+
+// AccessTokenClaims are the claims of an Auth0 access token
+type AccessTokenClaims struct {
+	RegisteredClaims
+
+	Scope          string   `json:"scope,omitempty"`
+	Permissions    []string `json:"permissions,omitempty"`
+	OrganizationID string   `json:"org_id,omitempty"`
+	GrantType      string   `json:"gty,omitempty"`
+
+	// Custom holds the Auth0 namespaced custom claims, keyed by full claim name
+	Custom CustomClaims `json:"-"`
+}
+
+// Scopes returns the space separated scope claim as a slice
+func (c AccessTokenClaims) Scopes() []string {
+	return strings.Fields(c.Scope)
+}
+
+// HasScope reports whether the token was granted scope
+func (c AccessTokenClaims) HasScope(scope string) bool {
+	return slices.Contains(c.Scopes(), scope)
+}
+
+// IDTokenClaims are the claims of an Auth0 ID token
+type IDTokenClaims struct {
+	RegisteredClaims
+
+	Nonce          string `json:"nonce,omitempty"`
+	AuthTime       int64  `json:"auth_time,omitempty"`
+	Name           string `json:"name,omitempty"`
+	GivenName      string `json:"given_name,omitempty"`
+	FamilyName     string `json:"family_name,omitempty"`
+	Nickname       string `json:"nickname,omitempty"`
+	Picture        string `json:"picture,omitempty"`
+	Email          string `json:"email,omitempty"`
+	EmailVerified  bool   `json:"email_verified,omitempty"`
+	UpdatedAt      string `json:"updated_at,omitempty"`
+	OrganizationID string `json:"org_id,omitempty"`
+
+	// Custom holds the Auth0 namespaced custom claims, keyed by full claim name
+	Custom CustomClaims `json:"-"`
+}
+
+// CustomClaims are Auth0 custom claims, which must be namespaced with a URL
+// such as https://example.com/roles
+type CustomClaims map[string]any
+
+// Namespace returns the custom claims under namespace with the namespace
+// stripped from their names. The namespace may be given with or without a
+// trailing slash.
+func (c CustomClaims) Namespace(namespace string) map[string]any {
+	prefix := strings.TrimSuffix(namespace, "/") + "/"
+	claims := map[string]any{}
+
+	for name, value := range c {
+		if rest, ok := strings.CutPrefix(name, prefix); ok {
+			claims[rest] = value
+		}
+	}
+
+	return claims
+}
+
+// String returns the custom claim name as a string, if it is one
+func (c CustomClaims) String(name string) (string, bool) {
+	s, ok := c[name].(string)
+	return s, ok
+}
+
+// Strings returns the custom claim name as a string slice, if it is one
+func (c CustomClaims) Strings(name string) ([]string, bool) {
+	values, ok := c[name].([]any)
+	if !ok {
+		return nil, false
+	}
+
+	strs := make([]string, 0, len(values))
+
+	for _, v := range values {
+		s, ok := v.(string)
+		if !ok {
+			return nil, false
+		}
+
+		strs = append(strs, s)
+	}
+
+	return strs, true
+}
+
+func isNamespaced(name string) bool {
+	return strings.HasPrefix(name, "https://") || strings.HasPrefix(name, "http://")
+}
+
+func customClaims(payload []byte) (CustomClaims, error) {
+	var all map[string]any
+	if err := json.Unmarshal(payload, &all); err != nil {
+		return nil, err
+	}
+
+	custom := CustomClaims{}
+
+	for name, value := range all {
+		if isNamespaced(name) {
+			custom[name] = value
+		}
+	}
+
+	return custom, nil
+}
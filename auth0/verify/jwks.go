@@ -0,0 +1,209 @@
+package verify
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	gohttp "net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/zenoss/go-auth0/auth0/http"
+)
+
+const (
+	// DefaultCacheTTL is how long a fetched JWKS is trusted before it is refetched
+	DefaultCacheTTL = 10 * time.Minute
+	// minRefreshInterval bounds how often an unknown kid can trigger a refetch
+	minRefreshInterval = 5 * time.Second
+)
+
+// JSONWebKey is a single key from a JSON Web Key Set
+type JSONWebKey struct {
+	KeyType   string   `json:"kty,omitempty"`
+	KeyID     string   `json:"kid,omitempty"`
+	Use       string   `json:"use,omitempty"`
+	Algorithm string   `json:"alg,omitempty"`
+	N         string   `json:"n,omitempty"`
+	E         string   `json:"e,omitempty"`
+	Curve     string   `json:"crv,omitempty"`
+	X         string   `json:"x,omitempty"`
+	Y         string   `json:"y,omitempty"`
+	X5C       []string `json:"x5c,omitempty"`
+}
+
+// JSONWebKeySet is the document served from /.well-known/jwks.json
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// PublicKey decodes the key material of a JSONWebKey
+func (k JSONWebKey) PublicKey() (crypto.PublicKey, error) {
+	switch k.KeyType {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("go-auth0: invalid RSA modulus for key %q: %w", k.KeyID, err)
+		}
+
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("go-auth0: invalid RSA exponent for key %q: %w", k.KeyID, err)
+		}
+
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("go-auth0: RSA exponent too large for key %q", k.KeyID)
+		}
+
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+
+		switch k.Curve {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("go-auth0: unsupported curve %q for key %q", k.Curve, k.KeyID)
+		}
+
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("go-auth0: invalid EC x coordinate for key %q: %w", k.KeyID, err)
+		}
+
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("go-auth0: invalid EC y coordinate for key %q: %w", k.KeyID, err)
+		}
+
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("go-auth0: unsupported key type %q for key %q", k.KeyType, k.KeyID)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(big.Int).SetBytes(data), nil
+}
+
+type cachedKey struct {
+	key       crypto.PublicKey
+	algorithm string
+}
+
+// KeySet fetches and caches the JSON Web Key Set of an Auth0 tenant.
+// Keys are refetched when the cache expires or when a token is signed
+// with a key ID that has not been seen yet, which handles key rotation.
+type KeySet struct {
+	c   *http.Client
+	url string
+	ttl time.Duration
+
+	mu        sync.RWMutex
+	keys      map[string]cachedKey
+	fetchedAt time.Time
+
+	group singleflight.Group
+}
+
+// NewKeySet creates a KeySet that fetches keys from jwksURL using client
+func NewKeySet(client *http.Client, jwksURL string, ttl time.Duration) *KeySet {
+	if ttl <= 0 {
+		ttl = DefaultCacheTTL
+	}
+
+	return &KeySet{
+		c:   client,
+		url: jwksURL,
+		ttl: ttl,
+	}
+}
+
+// Key returns the public key with the given key ID, fetching the key set
+// if it is not cached yet, is stale, or does not contain the key.
+func (ks *KeySet) Key(kid string) (crypto.PublicKey, string, error) {
+	ks.mu.RLock()
+	cached, ok := ks.keys[kid]
+	fresh := time.Since(ks.fetchedAt) < ks.ttl
+	recent := time.Since(ks.fetchedAt) < minRefreshInterval
+	ks.mu.RUnlock()
+
+	if ok && fresh {
+		return cached.key, cached.algorithm, nil
+	}
+
+	if !ok && recent {
+		return nil, "", fmt.Errorf("%w: %q", ErrKeyNotFound, kid)
+	}
+
+	if err := ks.Refresh(); err != nil {
+		return nil, "", err
+	}
+
+	ks.mu.RLock()
+	cached, ok = ks.keys[kid]
+	ks.mu.RUnlock()
+
+	if !ok {
+		return nil, "", fmt.Errorf("%w: %q", ErrKeyNotFound, kid)
+	}
+
+	return cached.key, cached.algorithm, nil
+}
+
+// Refresh refetches the key set. Concurrent callers share a single request.
+func (ks *KeySet) Refresh() error {
+	_, err, _ := ks.group.Do(ks.url, func() (any, error) {
+		var set JSONWebKeySet
+
+		// The JWKS URL is absolute, so the request bypasses the client API
+		req, err := gohttp.NewRequest(gohttp.MethodGet, ks.url, gohttp.NoBody)
+		if err == nil {
+			err = ks.c.Do(req, &set)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("go-auth0: cannot fetch JWKS from %s: %w", ks.url, err)
+		}
+
+		keys := make(map[string]cachedKey, len(set.Keys))
+
+		for _, jwk := range set.Keys {
+			if jwk.Use != "" && jwk.Use != "sig" {
+				continue
+			}
+
+			key, err := jwk.PublicKey()
+			if err != nil {
+				// Skip keys we cannot use rather than failing the whole set
+				continue
+			}
+
+			keys[jwk.KeyID] = cachedKey{key: key, algorithm: jwk.Algorithm}
+		}
+
+		ks.mu.Lock()
+		ks.keys = keys
+		ks.fetchedAt = time.Now()
+		ks.mu.Unlock()
+
+		return nil, nil
+	})
+
+	return err
+}
@@ -0,0 +1,349 @@
+package verify
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	gohttp "net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/zenoss/go-auth0/auth0/http"
+)
+
+var (
+	// ErrMalformedToken is returned when a token cannot be decoded
+	ErrMalformedToken = errors.New("go-auth0: malformed token")
+	// ErrUnsupportedAlgorithm is returned when a token is signed with an algorithm that is not allowed
+	ErrUnsupportedAlgorithm = errors.New("go-auth0: unsupported signing algorithm")
+	// ErrKeyNotFound is returned when the JWKS has no key with the token's kid
+	ErrKeyNotFound = errors.New("go-auth0: signing key not found")
+	// ErrInvalidSignature is returned when the token signature does not verify
+	ErrInvalidSignature = errors.New("go-auth0: invalid token signature")
+	// ErrTokenExpired is returned when the token's exp is in the past
+	ErrTokenExpired = errors.New("go-auth0: token is expired")
+	// ErrTokenNotYetValid is returned when the token's nbf or iat is in the future
+	ErrTokenNotYetValid = errors.New("go-auth0: token is not valid yet")
+	// ErrInvalidIssuer is returned when the token's iss does not match
+	ErrInvalidIssuer = errors.New("go-auth0: invalid token issuer")
+	// ErrInvalidAudience is returned when the token's aud does not match
+	ErrInvalidAudience = errors.New("go-auth0: invalid token audience")
+	// ErrInvalidAuthorizedParty is returned when the token's azp does not match
+	ErrInvalidAuthorizedParty = errors.New("go-auth0: invalid token authorized party")
+	// ErrInvalidNonce is returned when an ID token's nonce does not match
+	ErrInvalidNonce = errors.New("go-auth0: invalid token nonce")
+	// ErrMissingClaim is returned when a required claim is absent
+	ErrMissingClaim = errors.New("go-auth0: missing required claim")
+	// ErrMissingClientID is returned when verifying an ID token with a
+	// Validator configured without a ClientID
+	ErrMissingClientID = errors.New("go-auth0: client ID is required to verify ID tokens")
+	// ErrMissingAudience is returned when verifying an access token with a
+	// Validator configured without an Audience
+	ErrMissingAudience = errors.New("go-auth0: audience is required to verify access tokens")
+)
+
+// Config configures a Validator
+type Config struct {
+	// Domain is the Auth0 domain. Issuer and JWKSURL are derived from it
+	// unless they are set explicitly.
+	Domain string
+	// Issuer is the expected iss claim, e.g. https://example.auth0.com/
+	Issuer string
+	// JWKSURL is where signing keys are fetched from
+	JWKSURL string
+	// Audience lists the accepted aud values for access tokens. It is required
+	// to verify access tokens.
+	Audience []string
+	// ClientID is the expected aud (and azp) of ID tokens. It is required to
+	// verify ID tokens.
+	ClientID string
+	// AuthorizedParties, if set, restricts the azp of access tokens
+	AuthorizedParties []string
+	// Leeway allows for clock skew when checking exp, nbf and iat
+	Leeway time.Duration
+	// Algorithms lists the accepted signing algorithms. Defaults to RS256.
+	Algorithms []string
+	// CacheTTL is how long fetched keys are cached. Defaults to DefaultCacheTTL.
+	CacheTTL time.Duration
+	// Client is used to fetch the JWKS. Defaults to a plain http client.
+	Client *http.Client
+}
+
+// Validator validates Auth0 access and ID tokens
+type Validator struct {
+	cfg  Config
+	keys *KeySet
+}
+
+// New creates a Validator from cfg
+func New(cfg Config) *Validator {
+	if cfg.Issuer == "" && cfg.Domain != "" {
+		cfg.Issuer = "https://" + cfg.Domain + "/"
+	}
+
+	if cfg.JWKSURL == "" {
+		cfg.JWKSURL = strings.TrimSuffix(cfg.Issuer, "/") + "/.well-known/jwks.json"
+	}
+
+	if len(cfg.Algorithms) == 0 {
+		cfg.Algorithms = []string{"RS256"}
+	}
+
+	if cfg.Client == nil {
+		cfg.Client = &http.Client{
+			Doer: &http.RootClient{
+				Client: &gohttp.Client{Timeout: 30 * time.Second},
+			},
+		}
+	}
+
+	return &Validator{
+		cfg:  cfg,
+		keys: NewKeySet(cfg.Client, cfg.JWKSURL, cfg.CacheTTL),
+	}
+}
+
+// Keys returns the key set used by the validator
+func (v *Validator) Keys() *KeySet {
+	return v.keys
+}
+
+// VerifyAccessToken validates the signature and claims of an access token
+func (v *Validator) VerifyAccessToken(token string) (*AccessTokenClaims, error) {
+	// Without an audience, tokens issued for any API of the tenant would be accepted
+	if len(v.cfg.Audience) == 0 {
+		return nil, ErrMissingAudience
+	}
+
+	payload, err := v.verifySignature(token)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims AccessTokenClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMalformedToken, err)
+	}
+
+	if claims.Custom, err = customClaims(payload); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMalformedToken, err)
+	}
+
+	if err := v.validateRegistered(claims.RegisteredClaims); err != nil {
+		return nil, err
+	}
+
+	if !slices.ContainsFunc(v.cfg.Audience, claims.Audience.Contains) {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidAudience, claims.Audience)
+	}
+
+	if len(v.cfg.AuthorizedParties) > 0 && !slices.Contains(v.cfg.AuthorizedParties, claims.AuthorizedParty) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidAuthorizedParty, claims.AuthorizedParty)
+	}
+
+	return &claims, nil
+}
+
+// VerifyIDToken validates the signature and claims of an ID token. If nonce
+// is not empty, it must match the nonce claim.
+func (v *Validator) VerifyIDToken(token, nonce string) (*IDTokenClaims, error) {
+	// Without a client ID, ID tokens issued to any application would be accepted
+	if v.cfg.ClientID == "" {
+		return nil, ErrMissingClientID
+	}
+
+	payload, err := v.verifySignature(token)
+	if err != nil {
+		return nil, err
+	}
+
+	var claims IDTokenClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMalformedToken, err)
+	}
+
+	if claims.Custom, err = customClaims(payload); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMalformedToken, err)
+	}
+
+	if err := v.validateRegistered(claims.RegisteredClaims); err != nil {
+		return nil, err
+	}
+
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("%w: sub", ErrMissingClaim)
+	}
+
+	if claims.IssuedAt == 0 {
+		return nil, fmt.Errorf("%w: iat", ErrMissingClaim)
+	}
+
+	if !claims.Audience.Contains(v.cfg.ClientID) {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidAudience, claims.Audience)
+	}
+
+	// Per OIDC, azp is required with multiple audiences and must be the client
+	if (len(claims.Audience) > 1 || claims.AuthorizedParty != "") && claims.AuthorizedParty != v.cfg.ClientID {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidAuthorizedParty, claims.AuthorizedParty)
+	}
+
+	if nonce != "" && claims.Nonce != nonce {
+		return nil, ErrInvalidNonce
+	}
+
+	return &claims, nil
+}
+
+func (v *Validator) validateRegistered(claims RegisteredClaims) error {
+	now := time.Now()
+
+	if claims.Issuer != v.cfg.Issuer {
+		return fmt.Errorf("%w: %q", ErrInvalidIssuer, claims.Issuer)
+	}
+
+	if claims.ExpiresAt == 0 {
+		return fmt.Errorf("%w: exp", ErrMissingClaim)
+	}
+
+	if now.After(time.Unix(claims.ExpiresAt, 0).Add(v.cfg.Leeway)) {
+		return ErrTokenExpired
+	}
+
+	if claims.NotBefore != 0 && now.Before(time.Unix(claims.NotBefore, 0).Add(-v.cfg.Leeway)) {
+		return ErrTokenNotYetValid
+	}
+
+	if claims.IssuedAt != 0 && now.Before(time.Unix(claims.IssuedAt, 0).Add(-v.cfg.Leeway)) {
+		return ErrTokenNotYetValid
+	}
+
+	return nil
+}
+
+type header struct {
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+	Type      string `json:"typ"`
+}
+
+// verifySignature checks the token's signature and returns its decoded payload
+func (v *Validator) verifySignature(token string) ([]byte, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrMalformedToken
+	}
+
+	headerData, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMalformedToken, err)
+	}
+
+	var hdr header
+	if err := json.Unmarshal(headerData, &hdr); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMalformedToken, err)
+	}
+
+	if !slices.Contains(v.cfg.Algorithms, hdr.Algorithm) {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedAlgorithm, hdr.Algorithm)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMalformedToken, err)
+	}
+
+	key, keyAlg, err := v.keys.Key(hdr.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if keyAlg != "" && keyAlg != hdr.Algorithm {
+		return nil, fmt.Errorf("%w: key %q is for %s", ErrUnsupportedAlgorithm, hdr.KeyID, keyAlg)
+	}
+
+	if err := verifySignature(hdr.Algorithm, key, []byte(parts[0]+"."+parts[1]), signature); err != nil {
+		return nil, err
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMalformedToken, err)
+	}
+
+	return payload, nil
+}
+
+func hashFor(alg string) (crypto.Hash, error) {
+	switch alg[2:] {
+	case "256":
+		return crypto.SHA256, nil
+	case "384":
+		return crypto.SHA384, nil
+	case "512":
+		return crypto.SHA512, nil
+	default:
+		return 0, fmt.Errorf("%w: %q", ErrUnsupportedAlgorithm, alg)
+	}
+}
+
+func verifySignature(alg string, key crypto.PublicKey, signed, signature []byte) error {
+	if len(alg) != 5 {
+		return fmt.Errorf("%w: %q", ErrUnsupportedAlgorithm, alg)
+	}
+
+	hash, err := hashFor(alg)
+	if err != nil {
+		return err
+	}
+
+	hasher := hash.New()
+	_, _ = hasher.Write(signed)
+	digest := hasher.Sum(nil)
+
+	switch alg[:2] {
+	case "RS", "PS":
+		pub, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("%w: %s requires an RSA key", ErrUnsupportedAlgorithm, alg)
+		}
+
+		if alg[0] == 'P' {
+			err = rsa.VerifyPSS(pub, hash, digest, signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		} else {
+			err = rsa.VerifyPKCS1v15(pub, hash, digest, signature)
+		}
+
+		if err != nil {
+			return ErrInvalidSignature
+		}
+
+		return nil
+	case "ES":
+		pub, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("%w: %s requires an EC key", ErrUnsupportedAlgorithm, alg)
+		}
+
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return ErrInvalidSignature
+		}
+
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+
+		if !ecdsa.Verify(pub, digest, r, s) {
+			return ErrInvalidSignature
+		}
+
+		return nil
+	default:
+		return fmt.Errorf("%w: %q", ErrUnsupportedAlgorithm, alg)
+	}
+}
@@ -0,0 +1,349 @@
+package verify_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	gohttp "net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zenoss/go-auth0/auth0/http"
+	"github.com/zenoss/go-auth0/auth0/verify"
+)
+
+const (
+	testAudience = "https://api.example.com"
+	testClientID = "client123"
+)
+
+// jwksServer is a local stand-in for https://<domain>/.well-known/jwks.json
+type jwksServer struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	keys    []verify.JSONWebKey
+	fetches atomic.Int32
+}
+
+func newJWKSServer(t *testing.T) *jwksServer {
+	s := &jwksServer{}
+	s.Server = httptest.NewServer(gohttp.HandlerFunc(func(w gohttp.ResponseWriter, _ *gohttp.Request) {
+		s.fetches.Add(1)
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(verify.JSONWebKeySet{Keys: s.keys})
+	}))
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+func (s *jwksServer) setKeys(keys ...verify.JSONWebKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.keys = keys
+}
+
+func b64(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func rsaJWK(kid string, key *rsa.PrivateKey) verify.JSONWebKey {
+	return verify.JSONWebKey{
+		KeyType:   "RSA",
+		KeyID:     kid,
+		Use:       "sig",
+		Algorithm: "RS256",
+		N:         b64(key.N.Bytes()),
+		E:         b64(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+func sign(t *testing.T, alg, kid string, key crypto.Signer, claims map[string]any) string {
+	hdr, err := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	signed := b64(hdr) + "." + b64(payload)
+	digest := crypto.SHA256.New()
+	_, _ = digest.Write([]byte(signed))
+
+	var sig []byte
+
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		sig, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest.Sum(nil))
+		require.NoError(t, err)
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, k, digest.Sum(nil))
+		require.NoError(t, err)
+
+		sig = make([]byte, 64)
+		r.FillBytes(sig[:32])
+		s.FillBytes(sig[32:])
+	}
+
+	return signed + "." + b64(sig)
+}
+
+func baseClaims(issuer string) map[string]any {
+	now := time.Now()
+
+	return map[string]any{
+		"iss": issuer,
+		"sub": "auth0|user1",
+		"aud": []string{testAudience, issuer + "userinfo"},
+		"iat": now.Unix(),
+		"exp": now.Add(time.Hour).Unix(),
+		"azp": testClientID,
+	}
+}
+
+func setup(t *testing.T) (*jwksServer, *rsa.PrivateKey, *verify.Validator, string) {
+	srv := newJWKSServer(t)
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	srv.setKeys(rsaJWK("key1", key))
+
+	issuer := srv.URL + "/"
+	v := verify.New(verify.Config{
+		Issuer:   issuer,
+		Audience: []string{testAudience},
+		ClientID: testClientID,
+		Leeway:   time.Minute,
+	})
+
+	return srv, key, v, issuer
+}
+
+func TestVerifyAccessToken(t *testing.T) {
+	_, key, v, issuer := setup(t)
+
+	claims := baseClaims(issuer)
+	claims["scope"] = "openid read:devices"
+	claims["permissions"] = []string{"read:devices"}
+	claims["https://zenoss.com/tenant"] = "acme"
+	claims["https://zenoss.com/roles"] = []string{"admin"}
+
+	got, err := v.VerifyAccessToken(sign(t, "RS256", "key1", key, claims))
+	require.NoError(t, err)
+	assert.Equal(t, "auth0|user1", got.Subject)
+	assert.True(t, got.HasScope("read:devices"))
+	assert.Equal(t, []string{"read:devices"}, got.Permissions)
+
+	tenant, ok := got.Custom.String("https://zenoss.com/tenant")
+	assert.True(t, ok)
+	assert.Equal(t, "acme", tenant)
+
+	roles, ok := got.Custom.Strings("https://zenoss.com/roles")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"admin"}, roles)
+	assert.Equal(t, "acme", got.Custom.Namespace("https://zenoss.com/")["tenant"])
+}
+
+func TestVerifyAccessTokenClaims(t *testing.T) {
+	_, key, v, issuer := setup(t)
+
+	tests := []struct {
+		name   string
+		mutate func(map[string]any)
+		err    error
+	}{
+		{"expired", func(c map[string]any) { c["exp"] = time.Now().Add(-2 * time.Minute).Unix() }, verify.ErrTokenExpired},
+		{"expired within leeway", func(c map[string]any) { c["exp"] = time.Now().Add(-30 * time.Second).Unix() }, nil},
+		{"not yet valid", func(c map[string]any) { c["nbf"] = time.Now().Add(5 * time.Minute).Unix() }, verify.ErrTokenNotYetValid},
+		{"wrong issuer", func(c map[string]any) { c["iss"] = "https://evil.example.com/" }, verify.ErrInvalidIssuer},
+		{"wrong audience", func(c map[string]any) { c["aud"] = "https://other.example.com" }, verify.ErrInvalidAudience},
+		{"string audience", func(c map[string]any) { c["aud"] = testAudience }, nil},
+		{"missing exp", func(c map[string]any) { delete(c, "exp") }, verify.ErrMissingClaim},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := baseClaims(issuer)
+			tt.mutate(claims)
+
+			_, err := v.VerifyAccessToken(sign(t, "RS256", "key1", key, claims))
+			if tt.err == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestVerifyRejectsBadTokens(t *testing.T) {
+	_, key, v, issuer := setup(t)
+	token := sign(t, "RS256", "key1", key, baseClaims(issuer))
+
+	_, err := v.VerifyAccessToken("not-a-token")
+	assert.ErrorIs(t, err, verify.ErrMalformedToken)
+
+	_, err = v.VerifyAccessToken(token[:len(token)-4] + "AAAA")
+	assert.ErrorIs(t, err, verify.ErrInvalidSignature)
+
+	hdr := b64([]byte(`{"alg":"none","kid":"key1"}`))
+	_, err = v.VerifyAccessToken(hdr + "." + b64([]byte(`{}`)) + ".")
+	assert.ErrorIs(t, err, verify.ErrUnsupportedAlgorithm)
+
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	_, err = v.VerifyAccessToken(sign(t, "RS256", "key1", other, baseClaims(issuer)))
+	assert.ErrorIs(t, err, verify.ErrInvalidSignature)
+}
+
+func TestKeyRotation(t *testing.T) {
+	srv, key, v, issuer := setup(t)
+
+	_, err := v.VerifyAccessToken(sign(t, "RS256", "key1", key, baseClaims(issuer)))
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), srv.fetches.Load())
+
+	// Cached keys are reused
+	_, err = v.VerifyAccessToken(sign(t, "RS256", "key1", key, baseClaims(issuer)))
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), srv.fetches.Load())
+
+	// Unknown kids right after a fetch do not hammer the JWKS endpoint
+	rotated, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	srv.setKeys(rsaJWK("key1", key), rsaJWK("key2", rotated))
+
+	_, err = v.VerifyAccessToken(sign(t, "RS256", "key2", rotated, baseClaims(issuer)))
+	require.ErrorIs(t, err, verify.ErrKeyNotFound)
+	assert.Equal(t, int32(1), srv.fetches.Load())
+
+	// Once the set is refreshed the rotated key is found
+	require.NoError(t, v.Keys().Refresh())
+	_, err = v.VerifyAccessToken(sign(t, "RS256", "key2", rotated, baseClaims(issuer)))
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), srv.fetches.Load())
+}
+
+func TestVerifyIDToken(t *testing.T) {
+	_, key, v, issuer := setup(t)
+
+	claims := baseClaims(issuer)
+	claims["aud"] = testClientID
+	claims["nonce"] = "n-0S6_WzA2Mj"
+	claims["email"] = "someone@example.com"
+	claims["email_verified"] = true
+	token := sign(t, "RS256", "key1", key, claims)
+
+	got, err := v.VerifyIDToken(token, "n-0S6_WzA2Mj")
+	require.NoError(t, err)
+	assert.Equal(t, "someone@example.com", got.Email)
+	assert.True(t, got.EmailVerified)
+
+	_, err = v.VerifyIDToken(token, "other")
+	assert.ErrorIs(t, err, verify.ErrInvalidNonce)
+
+	claims["azp"] = "someone-else"
+	_, err = v.VerifyIDToken(sign(t, "RS256", "key1", key, claims), "")
+	assert.ErrorIs(t, err, verify.ErrInvalidAuthorizedParty)
+
+	claims["aud"] = []string{"someone-else"}
+	_, err = v.VerifyIDToken(sign(t, "RS256", "key1", key, claims), "")
+	assert.ErrorIs(t, err, verify.ErrInvalidAudience)
+}
+
+func TestVerifyIDTokenRequiresClientID(t *testing.T) {
+	srv, key, _, issuer := setup(t)
+
+	// An ID token issued to another application of the tenant
+	claims := baseClaims(issuer)
+	claims["aud"] = "another-app"
+	delete(claims, "azp")
+	token := sign(t, "RS256", "key1", key, claims)
+
+	// The JWKS is fetched from its absolute URL even if the client has an API
+	v := verify.New(verify.Config{
+		Issuer: issuer,
+		Client: &http.Client{
+			Doer: &http.RootClient{Client: srv.Client()},
+			API:  "https://example.auth0.com/api/v2",
+		},
+	})
+
+	_, err := v.VerifyIDToken(token, "")
+	assert.ErrorIs(t, err, verify.ErrMissingClientID)
+
+	v = verify.New(verify.Config{
+		Issuer:   issuer,
+		ClientID: testClientID,
+		Client: &http.Client{
+			Doer: &http.RootClient{Client: srv.Client()},
+			API:  "https://example.auth0.com/api/v2",
+		},
+	})
+
+	_, err = v.VerifyIDToken(token, "")
+	assert.ErrorIs(t, err, verify.ErrInvalidAudience)
+
+	claims["aud"] = testClientID
+	_, err = v.VerifyIDToken(sign(t, "RS256", "key1", key, claims), "")
+	assert.NoError(t, err)
+}
+
+func TestVerifyAccessTokenRequiresAudience(t *testing.T) {
+	_, key, _, issuer := setup(t)
+
+	// A Management API token of the same tenant
+	claims := baseClaims(issuer)
+	claims["aud"] = issuer + "api/v2/"
+	token := sign(t, "RS256", "key1", key, claims)
+
+	v := verify.New(verify.Config{Issuer: issuer})
+
+	_, err := v.VerifyAccessToken(token)
+	assert.ErrorIs(t, err, verify.ErrMissingAudience)
+
+	v = verify.New(verify.Config{Issuer: issuer, Audience: []string{testAudience}})
+
+	_, err = v.VerifyAccessToken(token)
+	assert.ErrorIs(t, err, verify.ErrInvalidAudience)
+}
+
+func TestVerifyES256(t *testing.T) {
+	srv := newJWKSServer(t)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	pub, err := key.PublicKey.ECDH()
+	require.NoError(t, err)
+
+	raw := pub.Bytes() // uncompressed point: 0x04 || X || Y
+	srv.setKeys(verify.JSONWebKey{
+		KeyType: "EC",
+		KeyID:   "ec1",
+		Curve:   "P-256",
+		X:       b64(raw[1:33]),
+		Y:       b64(raw[33:]),
+	})
+
+	issuer := srv.URL + "/"
+	v := verify.New(verify.Config{
+		Issuer:     issuer,
+		Audience:   []string{testAudience},
+		Algorithms: []string{"ES256"},
+	})
+
+	_, err = v.VerifyAccessToken(sign(t, "ES256", "ec1", key, baseClaims(issuer)))
+	assert.NoError(t, err)
+}